@@ -11,9 +11,19 @@
 	log "github.com/obalunenko/logger"
 
 	"github.com/obalunenko/georgia-tax-calculator/internal/service"
+	"github.com/obalunenko/georgia-tax-calculator/pkg/nbggovge"
 )
 
-const shutdownBroadcastTimeout = 30 * time.Second
+const (
+	shutdownBroadcastTimeout = 30 * time.Second
+	// maxCachedRates limits number of cached rates kept by long-running bot.
+	maxCachedRates = 10_000
+	// ratesJanitorInterval is how often expired rates are removed from cache.
+	ratesJanitorInterval = 10 * time.Minute
+	// ratesPerSecond and ratesBurst limit requests of rates sent to nbg.gov.ge by all users together.
+	ratesPerSecond = 5
+	ratesBurst     = 10
+)
 
 // userStorePath returns the path for the persisted user store file.
 // It can be overridden with the USER_STORE_PATH environment variable.
@@ -25,6 +35,30 @@ func userStorePath() string {
 	return "users.json"
 }
 
+// ratesCacheDir returns the directory for the persisted rates cache.
+// It can be overridden with the RATES_CACHE_DIR environment variable.
+func ratesCacheDir() string {
+	if p := os.Getenv("RATES_CACHE_DIR"); p != "" {
+		return p
+	}
+
+	return service.DefaultCacheDir()
+}
+
+// newRatesClient returns bounded rates cache, which removes expired rates in background until ctx is done.
+func newRatesClient(ctx context.Context) *nbggovge.CachedClient {
+	opts := []nbggovge.CacheOption{nbggovge.WithMaxEntries(maxCachedRates)}
+
+	if dir := ratesCacheDir(); dir != "" {
+		opts = append(opts, nbggovge.WithCacheDir(dir))
+	}
+
+	client := nbggovge.NewCachedClientWithDefaultTTL(nbggovge.New(nbggovge.WithRateLimit(ratesPerSecond, ratesBurst)), opts...)
+	client.StartJanitor(ctx, ratesJanitorInterval)
+
+	return client
+}
+
 // run starts the Telegram bot.
 func run(ctx context.Context, token string) error {
 	bot, err := telego.NewBot(token, telego.WithDefaultLogger(false, true))
@@ -52,7 +86,7 @@ func run(ctx context.Context, token string) error {
 	}
 
 	store := newSessionStore()
-	svc := service.New()
+	svc := service.NewWithClient(newRatesClient(ctx))
 
 	registerHandlers(bh, store, svc, users)
 
@@ -136,6 +170,7 @@ func registerHandlers(bh *telegohandler.BotHandler, store *sessionStore, svc ser
 	bh.HandleMessage(trackUserMsg(users, handleCancel(store)), telegohandler.CommandEqual(cmdCancel))
 	bh.HandleMessage(trackUserMsg(users, handleCalculate(store)), telegohandler.CommandEqual(cmdCalculate))
 	bh.HandleMessage(trackUserMsg(users, handleConvert(store)), telegohandler.CommandEqual(cmdConvert))
+	bh.HandleMessage(trackUserMsg(users, handleDeclarations(store)), telegohandler.CommandEqual(cmdDeclarations))
 
 	// Text input handler (for amount fields).
 	bh.HandleMessage(trackUserMsg(users, handleTextInput(store)), telegohandler.AnyMessageWithText())
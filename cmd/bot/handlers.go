@@ -8,15 +8,19 @@
 	"github.com/mymmrac/telego/telegohandler"
 	log "github.com/obalunenko/logger"
 
+	"github.com/obalunenko/georgia-tax-calculator/internal/report"
 	"github.com/obalunenko/georgia-tax-calculator/internal/service"
+	"github.com/obalunenko/georgia-tax-calculator/pkg/moneyutils"
+	"github.com/obalunenko/georgia-tax-calculator/pkg/nbggovge/currencies"
 )
 
 const (
-	cmdStart     = "start"
-	cmdCalculate = "calculate"
-	cmdConvert   = "convert"
-	cmdCancel    = "cancel"
-	cmdHelp      = "help"
+	cmdStart        = "start"
+	cmdCalculate    = "calculate"
+	cmdConvert      = "convert"
+	cmdCancel       = "cancel"
+	cmdHelp         = "help"
+	cmdDeclarations = "declarations"
 )
 
 // handleStart handles the /start command.
@@ -28,6 +32,7 @@ func handleStart(store *sessionStore) telegohandler.MessageHandler {
 			"I can help you calculate taxes and convert currencies according to official NBG rates.\n\n" +
 			"Available commands:\n" +
 			"• /calculate — Calculate taxes\n" +
+			"• /declarations — Build monthly declarations\n" +
 			"• /convert — Convert currency\n" +
 			"• /cancel — Cancel current operation\n" +
 			"• /help — Show this help message"
@@ -50,6 +55,8 @@ func handleHelp(store *sessionStore) telegohandler.MessageHandler {
 			"Commands:\n" +
 			"• /calculate — Start tax calculation flow\n" +
 			"  Calculates your taxes based on income, currency, and tax type\n\n" +
+			"• /declarations — Start monthly declarations flow\n" +
+			"  Groups incomes by month with tax due and filing deadline\n\n" +
 			"• /convert — Start currency conversion flow\n" +
 			"  Converts an amount using the official NBG exchange rate for a given date\n\n" +
 			"• /cancel — Cancel current operation and reset\n\n" +
@@ -80,9 +87,20 @@ func handleCancel(store *sessionStore) telegohandler.MessageHandler {
 
 // handleCalculate handles the /calculate command.
 func handleCalculate(store *sessionStore) telegohandler.MessageHandler {
+	return startCalcFlow(store, false)
+}
+
+// handleDeclarations handles the /declarations command.
+func handleDeclarations(store *sessionStore) telegohandler.MessageHandler {
+	return startCalcFlow(store, true)
+}
+
+// startCalcFlow starts the tax calculation flow, which results in monthly declarations when declarations is set.
+func startCalcFlow(store *sessionStore, declarations bool) telegohandler.MessageHandler {
 	return func(ctx *telegohandler.Context, msg telego.Message) error {
 		sess := store.get(msg.From.ID)
 		sess.flow = flowCalculate
+		sess.declarations = declarations
 		sess.calcStep = calcStepTaxType
 		sess.calcReq = service.CalculateRequest{}
 		sess.incomes = nil
@@ -152,10 +170,10 @@ func handleCalcTextInput(
 	switch sess.calcStep {
 	case calcStepYearIncome:
 		text := strings.TrimSpace(msg.Text)
-		if err := validateMoney(text); err != nil {
+		if err := validateMoney(text, currencies.GEL); err != nil {
 			_, sendErr := sendMessage(ctx, ctx.Bot(), &telego.SendMessageParams{
 				ChatID: telego.ChatID{ID: msg.Chat.ID},
-				Text:   fmt.Sprintf("❌ Invalid amount: %v\n\nPlease enter a valid number (e.g. 1500.00):", err),
+				Text:   fmt.Sprintf("❌ Invalid amount: %v\n\nPlease enter a valid number (e.g. 1500.00 or 1,500.00):", err),
 			})
 
 			return sendErr
@@ -174,17 +192,18 @@ func handleCalcTextInput(
 
 		return err
 	case calcStepAmount:
-		text := strings.TrimSpace(msg.Text)
-		if err := validateMoney(text); err != nil {
+		text, category := splitCategory(msg.Text)
+		if err := validateMoney(text, ""); err != nil {
 			_, sendErr := sendMessage(ctx, ctx.Bot(), &telego.SendMessageParams{
 				ChatID: telego.ChatID{ID: msg.Chat.ID},
-				Text:   fmt.Sprintf("❌ Invalid amount: %v\n\nPlease enter a valid number (e.g. 1500.00):", err),
+				Text:   fmt.Sprintf("❌ Invalid amount: %v\n\nPlease enter a valid number (e.g. 1500.00 or 1,500.00):", err),
 			})
 
 			return sendErr
 		}
 
 		sess.currentInc.Amount = text
+		sess.currentInc.Category = category
 		sess.calcStep = calcStepCurrency
 
 		kb := currencyKeyboard()
@@ -211,10 +230,10 @@ func handleConvertTextInput(
 	}
 
 	text := strings.TrimSpace(msg.Text)
-	if err := validateMoney(text); err != nil {
+	if err := validateMoney(text, ""); err != nil {
 		_, sendErr := sendMessage(ctx, ctx.Bot(), &telego.SendMessageParams{
 			ChatID: telego.ChatID{ID: msg.Chat.ID},
-			Text:   fmt.Sprintf("❌ Invalid amount: %v\n\nPlease enter a valid number (e.g. 1500.00):", err),
+			Text:   fmt.Sprintf("❌ Invalid amount: %v\n\nPlease enter a valid number (e.g. 1500.00 or 1,500.00):", err),
 		})
 
 		return sendErr
@@ -338,7 +357,7 @@ func handleCalcCallback(
 		_, err := sendMessage(ctx, ctx.Bot(), &telego.SendMessageParams{
 			ChatID: telego.ChatID{ID: chatID},
 			Text: fmt.Sprintf(
-				"✅ Date: %s-%s-%s\n\n💵 Enter the income amount:\n(e.g. 1500.00)",
+				"✅ Date: %s-%s-%s\n\n💵 Enter the income amount:\n(e.g. 1500.00, or 1500.00 #royalty to set category of income)",
 				sess.currentInc.Year, sess.currentInc.Month, data,
 			),
 		})
@@ -346,6 +365,10 @@ func handleCalcCallback(
 		return err
 
 	case calcStepCurrency:
+		if err := validateMoney(sess.currentInc.Amount, data); err != nil {
+			return sendCurrencyMismatch(ctx, chatID, err, "💱 Select the currency of income:")
+		}
+
 		sess.currentInc.Currency = data
 		sess.incomes = append(sess.incomes, sess.currentInc)
 		sess.currentInc = service.Income{}
@@ -424,11 +447,13 @@ func handleCalcCallback(
 			return err
 		}
 
-		resp, err := svc.Calculate(ctx.Context(), sess.calcReq)
+		text, cmd, err := calculate(ctx, svc, sess)
 		if err != nil {
+			log.WithError(ctx.Context(), err).Warn("calculate")
+
 			_, sendErr := sendMessage(ctx, ctx.Bot(), &telego.SendMessageParams{
 				ChatID: telego.ChatID{ID: chatID},
-				Text:   fmt.Sprintf("❌ Calculation error: %v\n\nPlease try again with /calculate", err),
+				Text:   fmt.Sprintf("❌ Calculation error: %s\n\nPlease try again with /%s", report.ErrorMessage(err), cmd),
 			})
 
 			return sendErr
@@ -436,7 +461,7 @@ func handleCalcCallback(
 
 		_, err = sendMessage(ctx, ctx.Bot(), &telego.SendMessageParams{
 			ChatID: telego.ChatID{ID: chatID},
-			Text:   formatCalcResult(resp),
+			Text:   text,
 		})
 
 		return err
@@ -503,6 +528,10 @@ func handleConvertCallback(
 		return err
 
 	case convertStepCurrencyFrom:
+		if err := validateMoney(sess.convertReq.Amount, data); err != nil {
+			return sendCurrencyMismatch(ctx, chatID, err, "💱 Select the source currency:")
+		}
+
 		sess.convertReq.CurrencyFrom = data
 		sess.convertStep = convertStepCurrencyTo
 
@@ -560,9 +589,11 @@ func handleConvertCallback(
 
 		resp, err := svc.Convert(ctx.Context(), sess.convertReq)
 		if err != nil {
+			log.WithError(ctx.Context(), err).Warn("convert")
+
 			_, sendErr := sendMessage(ctx, ctx.Bot(), &telego.SendMessageParams{
 				ChatID: telego.ChatID{ID: chatID},
-				Text:   fmt.Sprintf("❌ Conversion error: %v\n\nPlease try again with /convert", err),
+				Text:   fmt.Sprintf("❌ Conversion error: %s\n\nPlease try again with /convert", report.ErrorMessage(err)),
 			})
 
 			return sendErr
@@ -607,13 +638,30 @@ func formatIncomeList(incomes []service.Income) string {
 	b.WriteString("Captured incomes:\n")
 
 	for i, inc := range incomes {
-		b.WriteString(fmt.Sprintf("  %d) %s-%s-%s — %s %s\n",
-			i+1, inc.Year, inc.Month, inc.Day, inc.Amount, inc.Currency))
+		b.WriteString(fmt.Sprintf("  %d) %s\n", i+1, formatIncome(inc)))
 	}
 
 	return b.String()
 }
 
+// formatIncome formats captured income with its category, when it is set.
+func formatIncome(inc service.Income) string {
+	resp := fmt.Sprintf("%s-%s-%s — %s %s", inc.Year, inc.Month, inc.Day, inc.Amount, inc.Currency)
+
+	if inc.Category != "" {
+		resp += " #" + inc.Category
+	}
+
+	return resp
+}
+
+// splitCategory splits text of income amount into amount and category written after #, e.g. 1500 #royalty.
+func splitCategory(text string) (string, string) {
+	amount, category, _ := strings.Cut(text, "#")
+
+	return strings.TrimSpace(amount), strings.TrimSpace(category)
+}
+
 // formatCalcSummary formats the calculation request summary.
 func formatCalcSummary(sess *session) string {
 	var b strings.Builder
@@ -623,8 +671,7 @@ func formatCalcSummary(sess *session) string {
 	b.WriteString("\nIncomes:\n")
 
 	for i, inc := range sess.incomes {
-		b.WriteString(fmt.Sprintf("  %d) %s-%s-%s — %s %s\n",
-			i+1, inc.Year, inc.Month, inc.Day, inc.Amount, inc.Currency))
+		b.WriteString(fmt.Sprintf("  %d) %s\n", i+1, formatIncome(inc)))
 	}
 
 	return b.String()
@@ -635,28 +682,101 @@ func formatCalcResult(resp *service.CalculateResponse) string {
 	var b strings.Builder
 
 	b.WriteString("🧾 Tax Calculation Result\n\n")
-	b.WriteString(fmt.Sprintf("Tax Rate: %s\n", resp.TaxRate.String()))
+	b.WriteString(fmt.Sprintf("Tax Rate: %s\n", resp.TaxRates.String()))
 	b.WriteString(fmt.Sprintf("Year Income: %s\n", resp.YearIncome.String()))
 
 	if len(resp.Incomes) > 0 {
 		b.WriteString("\nIncomes converted to GEL:\n")
 
 		for i, inc := range resp.Incomes {
-			b.WriteString(fmt.Sprintf("  %d) %s → %s (rate: %s)\n",
+			b.WriteString(fmt.Sprintf("  %d) %s → %s (rate: %s%s)\n",
 				i+1,
 				inc.Amount.String(),
 				inc.Converted.String(),
 				inc.Rate.String(),
+				formatRateDate(inc.ConvertResponse),
+			))
+			b.WriteString(fmt.Sprintf("     Tax: %s (%s), year to date: %s\n",
+				inc.Tax.StringWith(resp.Rounding.Mode),
+				inc.TaxRate.String(),
+				inc.YearToDate.String(),
 			))
 		}
 	}
 
 	b.WriteString(fmt.Sprintf("\nTotal Income (GEL): %s\n", resp.TotalIncomeConverted.String()))
+
+	if len(resp.TaxParts) > 1 {
+		b.WriteString("\nTaxed at rates:\n")
+
+		for _, p := range resp.TaxParts {
+			b.WriteString(fmt.Sprintf("  • %s\n", p.StringWith(resp.Rounding.Mode)))
+		}
+	}
+
+	if len(resp.ThresholdCrossings) > 0 {
+		b.WriteString("\n⚠️ Annual threshold exceeded by incomes:\n")
+
+		for _, cr := range resp.ThresholdCrossings {
+			b.WriteString(fmt.Sprintf("  %d) %s\n", cr.Number, cr.Date.Format("2006-01-02")))
+		}
+
+		b.WriteByte('\n')
+	}
+
+	if !resp.Pension.IsZero() {
+		b.WriteString("\nPension Contributions:\n")
+		b.WriteString(fmt.Sprintf("  Employee: %s\n", resp.Pension.Employee.String()))
+		b.WriteString(fmt.Sprintf("  Employer: %s\n", resp.Pension.Employer.String()))
+		b.WriteString(fmt.Sprintf("  State: %s\n", resp.Pension.State.String()))
+		b.WriteString(fmt.Sprintf("  Total: %s\n\n", resp.Pension.Total().String()))
+	}
+
 	b.WriteString(fmt.Sprintf("Taxes to Pay: %s", resp.Tax.String()))
 
 	return b.String()
 }
 
+// calculate runs calculation requested in session and returns formatted result
+// along with the command that started the flow.
+func calculate(ctx *telegohandler.Context, svc service.Service, sess *session) (string, string, error) {
+	if sess.declarations {
+		resp, err := svc.Declarations(ctx.Context(), sess.calcReq)
+		if err != nil {
+			return "", cmdDeclarations, err
+		}
+
+		return formatDeclarationsResult(resp), cmdDeclarations, nil
+	}
+
+	resp, err := svc.Calculate(ctx.Context(), sess.calcReq)
+	if err != nil {
+		return "", cmdCalculate, err
+	}
+
+	return formatCalcResult(resp), cmdCalculate, nil
+}
+
+// formatDeclarationsResult formats the monthly declarations response.
+func formatDeclarationsResult(resp *service.DeclarationsResponse) string {
+	var b strings.Builder
+
+	b.WriteString("🗓 Monthly Declarations\n\n")
+	b.WriteString(fmt.Sprintf("Tax Rate: %s\n", resp.TaxRates.String()))
+
+	for _, d := range resp.Declarations {
+		b.WriteString(fmt.Sprintf("\n📅 %s\n", d.Period.Format("2006-01")))
+		b.WriteString(fmt.Sprintf("  Income: %s\n", d.Income.String()))
+		b.WriteString(fmt.Sprintf("  Tax: %s\n", d.Tax.String()))
+		b.WriteString(fmt.Sprintf("  Year Income: %s\n", d.YearIncome.String()))
+		b.WriteString(fmt.Sprintf("  Deadline: %s\n", d.Deadline.Format("2006-01-02")))
+	}
+
+	b.WriteString(fmt.Sprintf("\nTaxes to Pay: %s", resp.Tax.String()))
+
+	return b.String()
+}
+
 // formatConvertSummary formats the convert request for display.
 func formatConvertSummary(req service.ConvertRequest) string {
 	return fmt.Sprintf("Date: %s-%s-%s\nAmount: %s %s\nConvert to: %s",
@@ -676,31 +796,51 @@ func formatConvertResult(resp *service.ConvertResponse) string {
 	b.WriteString(fmt.Sprintf("Converted: %s\n", resp.Converted.String()))
 	b.WriteString(fmt.Sprintf("Rate: %s", resp.Rate.String()))
 
+	if !resp.RateDate.IsZero() {
+		b.WriteString(fmt.Sprintf("\nRate Date: %s", resp.RateDate.Format("2006-01-02")))
+	}
+
 	return b.String()
 }
 
-// validateMoney validates that s is a valid money amount.
-func validateMoney(s string) error {
+// formatRateDate formats the date of the rate when it differs from the income date.
+func formatRateDate(resp service.ConvertResponse) string {
+	if resp.RateDate.IsZero() || resp.RateDate.Equal(resp.Date) {
+		return ""
+	}
+
+	return " of " + resp.RateDate.Format("2006-01-02")
+}
+
+// validateMoney validates that s is a valid money amount. Currency written along with amount, e.g. €99,
+// must be the currency when it is set, otherwise amount would be converted from another currency.
+func validateMoney(s, currency string) error {
 	s = strings.TrimSpace(s)
 	if s == "" {
 		return fmt.Errorf("value is required")
 	}
 
-	if _, err := parseMoneyAmount(s); err != nil {
-		return fmt.Errorf("invalid amount: %w", err)
+	a, err := moneyutils.ParseAmount(s)
+	if err != nil {
+		return err
+	}
+
+	if currency != "" && a.Currency != "" && a.Currency != currency {
+		return fmt.Errorf("amount %s is in %s, not in %s", s, a.Currency, currency)
 	}
 
 	return nil
 }
 
-// parseMoneyAmount parses a money amount from string.
-func parseMoneyAmount(s string) (float64, error) {
-	var f float64
+// sendCurrencyMismatch asks to select currency again when selected one differs from currency written with amount.
+func sendCurrencyMismatch(ctx *telegohandler.Context, chatID int64, mismatch error, prompt string) error {
+	kb := currencyKeyboard()
 
-	_, err := fmt.Sscanf(s, "%f", &f)
-	if err != nil {
-		return 0, fmt.Errorf("parse amount: %w", err)
-	}
+	_, err := sendMessage(ctx, ctx.Bot(), &telego.SendMessageParams{
+		ChatID:      telego.ChatID{ID: chatID},
+		Text:        fmt.Sprintf("❌ %v\n\n%s", mismatch, prompt),
+		ReplyMarkup: &kb,
+	})
 
-	return f, nil
+	return err
 }
@@ -4,9 +4,11 @@
 	"fmt"
 	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/mymmrac/telego"
+	"github.com/shopspring/decimal"
 
 	"github.com/obalunenko/georgia-tax-calculator/internal/taxes"
 	"github.com/obalunenko/georgia-tax-calculator/pkg/dateutils"
@@ -65,15 +67,11 @@ func taxTypeKeyboard() (telego.InlineKeyboardMarkup, error) {
 		return telego.InlineKeyboardMarkup{}, fmt.Errorf("get tax rates: %w", err)
 	}
 
-	sort.Slice(rates, func(i, j int) bool {
-		return rates[i].Type > rates[j].Type
-	})
-
-	const toPercentage float64 = 100
+	const toPercentage int64 = 100
 
 	items := make([]string, len(rates))
 	for i, r := range rates {
-		pct := moneyutils.Multiply(r.Rate, toPercentage)
+		pct := moneyutils.Multiply(moneyutils.FromFloat(r.Rate), decimal.NewFromInt(toPercentage))
 		items[i] = fmt.Sprintf("%s (%s%%)", r.Type.String(), moneyutils.ToString(pct))
 	}
 
@@ -83,13 +81,20 @@ func taxTypeKeyboard() (telego.InlineKeyboardMarkup, error) {
 // taxTypeFromCallback extracts the tax type string from an item string like "Small Business (1%)".
 func taxTypeFromItem(item string) string {
 	// The item is in the format "TaxType (X%)" - we need just the type name.
+	// Registered names may prefix each other, so the longest match wins.
+	var match string
+
 	for _, tt := range taxes.AllTaxTypes() {
-		if len(item) >= len(tt.String()) && item[:len(tt.String())] == tt.String() {
-			return tt.String()
+		if strings.HasPrefix(item, tt.String()) && len(tt.String()) > len(match) {
+			match = tt.String()
 		}
 	}
 
-	return item
+	if match == "" {
+		return item
+	}
+
+	return match
 }
 
 // yearKeyboard builds the year selection keyboard.
@@ -54,6 +54,8 @@ type session struct {
 	calcReq    service.CalculateRequest
 	currentInc service.Income
 	incomes    []service.Income
+	// declarations is set when calculation results should be grouped into monthly declarations.
+	declarations bool
 
 	// convert state
 	convertStep convertStep
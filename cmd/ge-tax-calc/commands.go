@@ -1,27 +1,233 @@
 package main
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/urfave/cli/v3"
+
+	"github.com/obalunenko/georgia-tax-calculator/internal/chart"
+	"github.com/obalunenko/georgia-tax-calculator/internal/models"
+	"github.com/obalunenko/georgia-tax-calculator/internal/report"
+	"github.com/obalunenko/georgia-tax-calculator/internal/taxes"
 )
 
 func commands() []*cli.Command {
 	const (
-		cmdRun     = "run"
-		cmdConvert = "convert"
+		cmdRun          = "run"
+		cmdConvert      = "convert"
+		cmdDeclarations = "declarations"
+		cmdRates        = "rates"
+		cmdChart        = "chart"
 	)
 
 	cmds := []*cli.Command{
 		{
 			Name:   cmdRun,
 			Usage:  "Runs taxes calculations",
+			Flags:  calculateFlags(),
 			Action: menuCalcTaxes,
 		},
 		{
 			Name:   cmdConvert,
 			Usage:  "Runs currency converter",
+			Flags:  convertFlags(),
 			Action: menuConvert,
 		},
+		{
+			Name:   cmdDeclarations,
+			Usage:  "Builds monthly tax declarations",
+			Flags:  calculateFlags(),
+			Action: menuDeclarations,
+		},
+		{
+			Name:   cmdRates,
+			Usage:  "Shows official rates published by National Bank of Georgia",
+			Flags:  ratesFlags(),
+			Action: menuRates,
+		},
+		{
+			Name:   cmdChart,
+			Usage:  "Draws chart of official rates history",
+			Flags:  chartFlags(),
+			Action: menuChart,
+		},
 	}
 
 	return cmds
 }
+
+const (
+	flagOutput = "output"
+
+	flagAmount = "amount"
+	flagFrom   = "from"
+	flagTo     = "to"
+	flagDate   = "date"
+
+	flagCurrency = "currency"
+	flagWidth    = "width"
+	flagHeight   = "height"
+
+	flagIncomes    = "incomes"
+	flagTaxType    = "tax-type"
+	flagYearIncome = "year-income"
+
+	flagCategory     = "category"
+	flagRounding     = "rounding"
+	flagRoundingMode = "rounding-mode"
+)
+
+// globalFlags returns flags applied to every command.
+func globalFlags() []cli.Flag {
+	formats := make([]string, 0, len(report.Formats()))
+
+	for _, f := range report.Formats() {
+		formats = append(formats, string(f))
+	}
+
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:    flagOutput,
+			Aliases: []string{"o"},
+			Usage:   "Output format: " + strings.Join(formats, ", "),
+			Value:   string(report.FormatText),
+			Validator: func(s string) error {
+				_, err := report.ParseFormat(s)
+
+				return err
+			},
+		},
+	}
+}
+
+// calculateFlags returns flags of commands calculating taxes. Missing flags are asked interactively.
+func calculateFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:  flagIncomes,
+			Usage: "Path to CSV or JSON file with date (YYYY-MM-DD), currency and amount of incomes",
+		},
+		&cli.StringFlag{
+			Name:  flagTaxType,
+			Usage: "Tax type, e.g. \"small business\"",
+		},
+		&cli.StringFlag{
+			Name:  flagYearIncome,
+			Usage: "Income from the beginning of a calendar year in GEL",
+		},
+		&cli.StringFlag{
+			Name:  flagCategory,
+			Usage: "Category of incomes, e.g. royalty, for incomes without category column in incomes file",
+		},
+		&cli.StringFlag{
+			Name:  flagRounding,
+			Usage: "Step at which taxes are rounded: " + joinStrings(taxes.RoundingPolicies()),
+			Value: taxes.DefaultRounding.Policy.String(),
+			Validator: func(s string) error {
+				_, err := taxes.ParseRoundingPolicy(s)
+
+				return err
+			},
+		},
+		&cli.StringFlag{
+			Name:  flagRoundingMode,
+			Usage: "Rounding mode of taxes: " + joinStrings(models.RoundingModes()),
+			Value: taxes.DefaultRounding.Mode.String(),
+			Validator: func(s string) error {
+				_, err := models.ParseRoundingMode(s)
+
+				return err
+			},
+		},
+	}
+}
+
+// joinStrings joins string values of items with comma.
+func joinStrings[T fmt.Stringer](items []T) string {
+	values := make([]string, 0, len(items))
+
+	for _, item := range items {
+		values = append(values, item.String())
+	}
+
+	return strings.Join(values, ", ")
+}
+
+// convertFlags returns flags of convert command. Missing flags are asked interactively.
+func convertFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:  flagAmount,
+			Usage: "Amount to convert",
+		},
+		&cli.StringFlag{
+			Name:  flagFrom,
+			Usage: "Currency code to convert from",
+		},
+		&cli.StringFlag{
+			Name:  flagTo,
+			Usage: "Currency code to convert to",
+		},
+		&cli.StringFlag{
+			Name:  flagDate,
+			Usage: "Date of conversion in YYYY-MM-DD format",
+		},
+	}
+}
+
+// ratesFlags returns flags of rates command.
+func ratesFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:  flagDate,
+			Usage: "Date of rates in YYYY-MM-DD format, today by default",
+		},
+		&cli.StringSliceFlag{
+			Name:  flagCurrency,
+			Usage: "Comma separated currency codes, all currencies by default",
+		},
+		&cli.StringFlag{
+			Name:  flagFrom,
+			Usage: "The first date of rates history in YYYY-MM-DD format",
+		},
+		&cli.StringFlag{
+			Name:  flagTo,
+			Usage: "The last date of rates history in YYYY-MM-DD format, today by default",
+		},
+	}
+}
+
+// chartFlags returns flags of chart command.
+func chartFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringSliceFlag{
+			Name:     flagCurrency,
+			Usage:    "Comma separated currency codes, a chart is drawn for each currency",
+			Required: true,
+		},
+		&cli.StringFlag{
+			Name:     flagFrom,
+			Usage:    "The first date of chart in YYYY-MM-DD format",
+			Required: true,
+		},
+		&cli.StringFlag{
+			Name:  flagTo,
+			Usage: "The last date of chart in YYYY-MM-DD format, today by default",
+		},
+		&cli.StringFlag{
+			Name:  flagIncomes,
+			Usage: "Path to CSV or JSON file with incomes, dates of incomes are marked on chart",
+		},
+		&cli.IntFlag{
+			Name:  flagWidth,
+			Usage: "Width of chart in columns",
+			Value: chart.DefaultWidth,
+		},
+		&cli.IntFlag{
+			Name:  flagHeight,
+			Usage: "Height of chart in rows",
+			Value: chart.DefaultHeight,
+		},
+	}
+}
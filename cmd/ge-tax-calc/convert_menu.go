@@ -10,8 +10,9 @@
 	"github.com/obalunenko/georgia-tax-calculator/pkg/nbggovge/currencies"
 )
 
-func runConvertMenu() (service.ConvertRequest, error) {
-	model := newConvertModel()
+// runConvertMenu asks for inputs of conversion that are not set in prefill.
+func runConvertMenu(prefill service.ConvertRequest) (service.ConvertRequest, error) {
+	model := newConvertModel(prefill)
 	if _, err := tea.NewProgram(model).Run(); err != nil {
 		return service.ConvertRequest{}, err
 	}
@@ -43,8 +44,10 @@ type convertModel struct {
 	err    error
 }
 
-func newConvertModel() *convertModel {
-	return &convertModel{}
+func newConvertModel(prefill service.ConvertRequest) *convertModel {
+	return &convertModel{
+		req: prefill,
+	}
 }
 
 func (m *convertModel) Init() tea.Cmd {
@@ -53,7 +56,7 @@ func (m *convertModel) Init() tea.Cmd {
 	}
 
 	if m.prompt == nil {
-		return m.setPrompt(newSelectPrompt("Select year of conversion", yearOptions(), defaultYearValue()))
+		return m.moveTo(convertStepYear)
 	}
 
 	return m.prompt.Init()
@@ -109,8 +112,44 @@ func (m *convertModel) advance() tea.Cmd {
 	switch m.step {
 	case convertStepYear:
 		m.req.Year = m.prompt.Value()
-		m.step = convertStepMonth
+	case convertStepMonth:
+		m.req.Month = m.prompt.Value()
+	case convertStepDay:
+		m.req.Day = m.prompt.Value()
+	case convertStepAmount:
+		m.req.Amount = m.prompt.Value()
+	case convertStepCurrencyFrom:
+		m.req.CurrencyFrom = m.prompt.Value()
+	case convertStepCurrencyTo:
+		m.req.CurrencyTo = m.prompt.Value()
+	case convertStepConfirm:
+		if m.prompt.Value() == confirmYes {
+			m.step = convertStepDone
+			return tea.Quit
+		}
 
+		m.req = service.ConvertRequest{}
+
+		return m.moveTo(convertStepYear)
+	default:
+		return tea.Quit
+	}
+
+	return m.moveTo(m.step + 1)
+}
+
+// moveTo shows prompt of step, skipping steps which values are already set.
+func (m *convertModel) moveTo(step convertStep) tea.Cmd {
+	for step < convertStepConfirm && m.filled(step) {
+		step++
+	}
+
+	m.step = step
+
+	switch step {
+	case convertStepYear:
+		return m.setPrompt(newSelectPrompt("Select year of conversion", yearOptions(), defaultYearValue()))
+	case convertStepMonth:
 		opts, err := monthOptions(m.req.Year)
 		if err != nil {
 			m.err = err
@@ -118,10 +157,7 @@ func (m *convertModel) advance() tea.Cmd {
 		}
 
 		return m.setPrompt(newSelectPrompt("Select month of conversion", opts, defaultMonthValue(m.req.Year)))
-	case convertStepMonth:
-		m.req.Month = m.prompt.Value()
-		m.step = convertStepDay
-
+	case convertStepDay:
 		opts, err := dayOptions(m.req.Year, m.req.Month)
 		if err != nil {
 			m.err = err
@@ -129,44 +165,42 @@ func (m *convertModel) advance() tea.Cmd {
 		}
 
 		return m.setPrompt(newSelectPrompt("Select day of conversion", opts, defaultDayValue(m.req.Year, m.req.Month)))
-	case convertStepDay:
-		m.req.Day = m.prompt.Value()
-		m.step = convertStepAmount
-
-		return m.setPrompt(newInputPrompt("Input amount to convert", "0.00", "", validateMoneyInput))
 	case convertStepAmount:
-		m.req.Amount = m.prompt.Value()
-		m.step = convertStepCurrencyFrom
-
-		return m.setPrompt(newSelectPrompt("Select currency of conversion 'from'", currencyOptions(), currencies.USD))
+		return m.setPrompt(newInputPrompt("Input amount to convert", "0.00", "", validateMoneyInput))
 	case convertStepCurrencyFrom:
-		m.req.CurrencyFrom = m.prompt.Value()
-		m.step = convertStepCurrencyTo
-
-		return m.setPrompt(newSelectPrompt("Select currency of conversion 'to'", currencyOptions(), currencies.GEL))
+		return m.setPrompt(newSelectPrompt("Select currency of conversion 'from'", currencyOptions(), currencies.USD))
 	case convertStepCurrencyTo:
-		m.req.CurrencyTo = m.prompt.Value()
-		m.step = convertStepConfirm
-
+		return m.setPrompt(newSelectPrompt("Select currency of conversion 'to'", currencyOptions(), currencies.GEL))
+	case convertStepConfirm:
 		prompt := newConfirmPrompt("Are your answers correct?")
 		prompt.SetNote("Selecting 'No' restarts the converter form.")
 
 		return m.setPrompt(prompt)
-	case convertStepConfirm:
-		if m.prompt.Value() == confirmYes {
-			m.step = convertStepDone
-			return tea.Quit
-		}
-
-		m.req = service.ConvertRequest{}
-		m.step = convertStepYear
-
-		return m.setPrompt(newSelectPrompt("Select year of conversion", yearOptions(), defaultYearValue()))
 	default:
 		return tea.Quit
 	}
 }
 
+// filled checks whether value of step is already set.
+func (m *convertModel) filled(step convertStep) bool {
+	switch step {
+	case convertStepYear:
+		return m.req.Year != ""
+	case convertStepMonth:
+		return m.req.Month != ""
+	case convertStepDay:
+		return m.req.Day != ""
+	case convertStepAmount:
+		return m.req.Amount != ""
+	case convertStepCurrencyFrom:
+		return m.req.CurrencyFrom != ""
+	case convertStepCurrencyTo:
+		return m.req.CurrencyTo != ""
+	default:
+		return false
+	}
+}
+
 func (m *convertModel) setPrompt(p *promptModel) tea.Cmd {
 	m.prompt = p
 
@@ -10,6 +10,10 @@
 )
 
 func printHeader(ctx context.Context, cmd *cli.Command) (context.Context, error) {
+	if !isTextOutput(cmd) {
+		return ctx, nil
+	}
+
 	const (
 		padding  int  = 1
 		minWidth int  = 0
@@ -47,7 +51,11 @@ func notFound(ctx context.Context, cmd *cli.Command, command string) {
 	}
 }
 
-func onExit(_ context.Context, _ *cli.Command) error {
+func onExit(_ context.Context, cmd *cli.Command) error {
+	if !isTextOutput(cmd) {
+		return nil
+	}
+
 	fmt.Println("Exit...")
 
 	return nil
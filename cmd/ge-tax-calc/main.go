@@ -7,6 +7,8 @@
 
 	log "github.com/obalunenko/logger"
 	"github.com/urfave/cli/v3"
+
+	"github.com/obalunenko/georgia-tax-calculator/internal/report"
 )
 
 func main() {
@@ -27,11 +29,13 @@ func main() {
 
 	app.CommandNotFound = notFound
 	app.Commands = commands()
+	app.Flags = globalFlags()
 	app.Version = printVersion(ctx)
 	app.Before = printHeader
 	app.After = onExit
 
 	if err := app.Run(ctx, os.Args); err != nil {
-		log.WithError(ctx, err).Fatal("Run failed")
+		// Message explains known errors to users, while error field keeps details.
+		log.WithError(ctx, err).Fatal(report.ErrorMessage(err))
 	}
 }
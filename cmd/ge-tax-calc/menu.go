@@ -3,54 +3,81 @@
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/savioxavier/termlink"
+	"github.com/shopspring/decimal"
 	"github.com/urfave/cli/v3"
 
+	"github.com/obalunenko/georgia-tax-calculator/internal/chart"
+	"github.com/obalunenko/georgia-tax-calculator/internal/importer"
+	"github.com/obalunenko/georgia-tax-calculator/internal/models"
+	"github.com/obalunenko/georgia-tax-calculator/internal/report"
 	"github.com/obalunenko/georgia-tax-calculator/internal/service"
+	"github.com/obalunenko/georgia-tax-calculator/internal/taxes"
+	"github.com/obalunenko/georgia-tax-calculator/pkg/dateutils"
+	"github.com/obalunenko/georgia-tax-calculator/pkg/moneyutils"
+	"github.com/obalunenko/georgia-tax-calculator/pkg/nbggovge/currencies"
 )
 
-func createLink(text, url string) {
-	if !termlink.SupportsHyperlinks() {
+func createLink(cmd *cli.Command, text, url string) {
+	if !termlink.SupportsHyperlinks() || !isTextOutput(cmd) {
 		return
 	}
 
 	fmt.Println(termlink.Link(text, url))
 }
 
-func menuCalcTaxes(ctx context.Context, _ *cli.Command) error {
-	createLink("Declarations", "https://decl.rs.ge/decls.aspx")
+func menuCalcTaxes(ctx context.Context, cmd *cli.Command) error {
+	createLink(cmd, "Declarations", "https://decl.rs.ge/decls.aspx")
 
-	req, err := runTaxDetailsMenu()
+	req, err := runCalculateMenu(cmd)
 	if err != nil {
-		return fmt.Errorf("failed to collect tax details: %w", err)
+		return err
 	}
 
-	incomes, err := runIncomeMenu()
+	svc := service.New()
+
+	resp, err := svc.Calculate(ctx, req)
 	if err != nil {
-		return fmt.Errorf("failed to collect incomes: %w", err)
+		return err
 	}
 
-	req.Income = incomes
+	return printReport(cmd, report.NewCalculation(*resp))
+}
 
-	svc := service.New()
+func menuDeclarations(ctx context.Context, cmd *cli.Command) error {
+	createLink(cmd, "Declarations", "https://decl.rs.ge/decls.aspx")
 
-	resp, err := svc.Calculate(ctx, req)
+	req, err := runCalculateMenu(cmd)
 	if err != nil {
 		return err
 	}
 
-	fmt.Println()
-	fmt.Println(resp)
-	fmt.Println()
+	resp, err := service.New().Declarations(ctx, req)
+	if err != nil {
+		return err
+	}
 
-	return nil
+	return printReport(cmd, report.NewDeclarations(*resp))
 }
 
-func menuConvert(ctx context.Context, _ *cli.Command) error {
-	req, err := runConvertMenu()
+func menuConvert(ctx context.Context, cmd *cli.Command) error {
+	req, err := convertRequestFromFlags(cmd)
 	if err != nil {
-		return fmt.Errorf("failed to collect converter input: %w", err)
+		return fmt.Errorf("invalid flags: %w", err)
+	}
+
+	if missing := missingConvertFlags(req); len(missing) != 0 {
+		if !isTextOutput(cmd) {
+			return missingFlagsError(cmd, missing)
+		}
+
+		req, err = runConvertMenu(req)
+		if err != nil {
+			return fmt.Errorf("failed to collect converter input: %w", err)
+		}
 	}
 
 	resp, err := service.New().Convert(ctx, req)
@@ -58,9 +85,400 @@ func menuConvert(ctx context.Context, _ *cli.Command) error {
 		return err
 	}
 
-	fmt.Println()
-	fmt.Println(resp)
-	fmt.Println()
+	return printReport(cmd, report.NewConversion(*resp))
+}
+
+func menuRates(ctx context.Context, cmd *cli.Command) error {
+	codes, err := currenciesFromFlags(cmd)
+	if err != nil {
+		return fmt.Errorf("invalid flags: %w", err)
+	}
+
+	if cmd.IsSet(flagFrom) || cmd.IsSet(flagTo) {
+		req, err := ratesHistoryRequestFromFlags(cmd)
+		if err != nil {
+			return fmt.Errorf("invalid flags: %w", err)
+		}
+
+		req.Currencies = codes
+
+		resp, err := service.New().RatesHistory(ctx, req)
+		if err != nil {
+			return err
+		}
+
+		return printReport(cmd, report.NewRatesHistory(*resp))
+	}
+
+	req := service.RatesRequest{Currencies: codes}
+
+	if cmd.IsSet(flagDate) {
+		req.Date, err = dateutils.ParseDate(cmd.String(flagDate))
+		if err != nil {
+			return fmt.Errorf("invalid flags: --%s: %w", flagDate, err)
+		}
+	}
+
+	resp, err := service.New().Rates(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	return printReport(cmd, report.NewRates(*resp))
+}
+
+func menuChart(ctx context.Context, cmd *cli.Command) error {
+	if !isTextOutput(cmd) {
+		return fmt.Errorf("--%s: chart supports only %s output", flagOutput, report.FormatText)
+	}
+
+	codes, err := currenciesFromFlags(cmd)
+	if err != nil {
+		return fmt.Errorf("invalid flags: %w", err)
+	}
+
+	req, err := ratesHistoryRequestFromFlags(cmd)
+	if err != nil {
+		return fmt.Errorf("invalid flags: %w", err)
+	}
+
+	req.Currencies = codes
+
+	opts := []chart.Option{
+		chart.WithSize(int(cmd.Int(flagWidth)), int(cmd.Int(flagHeight))),
+		chart.WithRange(req.From, req.To),
+	}
+
+	if cmd.IsSet(flagIncomes) {
+		dates, err := incomeDatesFromFile(cmd.String(flagIncomes))
+		if err != nil {
+			return fmt.Errorf("invalid flags: --%s: %w", flagIncomes, err)
+		}
+
+		opts = append(opts, chart.WithMarks("Incomes", dates))
+	}
+
+	resp, err := service.New().RatesHistory(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	w := cmd.Root().Writer
+
+	for _, h := range resp.Currencies {
+		points := make([]chart.Point, 0, len(h.Points))
+
+		// Rates are charted per unit of currency, as quantity of publication could change.
+		for _, p := range h.Points {
+			rate := moneyutils.Div(moneyutils.FromFloat(p.Rate), decimal.NewFromInt(int64(p.Quantity)))
+
+			points = append(points, chart.Point{Date: p.Date, Value: moneyutils.ToFloat(rate)})
+		}
+
+		if len(points) == 0 {
+			if _, err = fmt.Fprintf(w, "\n%s/%s: no rates published\n", h.Code, currencies.GEL); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		c, err := chart.Render(h.Code+"/"+currencies.GEL, points, opts...)
+		if err != nil {
+			return err
+		}
+
+		if _, err = fmt.Fprintf(w, "\n%s\n", c); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
+
+// incomeDatesFromFile returns dates of incomes in file.
+func incomeDatesFromFile(path string) ([]time.Time, error) {
+	incomes, err := importer.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	dates := make([]time.Time, 0, len(incomes))
+
+	for _, inc := range incomes {
+		date, err := inc.Date()
+		if err != nil {
+			return nil, err
+		}
+
+		dates = append(dates, date)
+	}
+
+	return dates, nil
+}
+
+// currenciesFromFlags returns upper-cased currency codes set by --currency flag.
+func currenciesFromFlags(cmd *cli.Command) ([]string, error) {
+	var codes []string
+
+	for _, val := range cmd.StringSlice(flagCurrency) {
+		if err := validateCurrencyInput(val); err != nil {
+			return nil, fmt.Errorf("--%s: %w", flagCurrency, err)
+		}
+
+		codes = append(codes, strings.ToUpper(strings.TrimSpace(val)))
+	}
+
+	return codes, nil
+}
+
+// ratesHistoryRequestFromFlags builds service.RatesHistoryRequest from --from and --to flags.
+func ratesHistoryRequestFromFlags(cmd *cli.Command) (service.RatesHistoryRequest, error) {
+	if cmd.IsSet(flagDate) {
+		return service.RatesHistoryRequest{}, fmt.Errorf("--%s could not be used with --%s and --%s", flagDate, flagFrom, flagTo)
+	}
+
+	if !cmd.IsSet(flagFrom) {
+		return service.RatesHistoryRequest{}, fmt.Errorf("--%s is required with --%s", flagFrom, flagTo)
+	}
+
+	from, err := dateutils.ParseDate(cmd.String(flagFrom))
+	if err != nil {
+		return service.RatesHistoryRequest{}, fmt.Errorf("--%s: %w", flagFrom, err)
+	}
+
+	now := time.Now()
+
+	// Dates of flags are in UTC, so today is too.
+	to := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	if cmd.IsSet(flagTo) {
+		to, err = dateutils.ParseDate(cmd.String(flagTo))
+		if err != nil {
+			return service.RatesHistoryRequest{}, fmt.Errorf("--%s: %w", flagTo, err)
+		}
+	}
+
+	return service.RatesHistoryRequest{From: from, To: to}, nil
+}
+
+// runCalculateMenu builds service.CalculateRequest from flags and asks for the rest of inputs.
+func runCalculateMenu(cmd *cli.Command) (service.CalculateRequest, error) {
+	req, err := calculateRequestFromFlags(cmd)
+	if err != nil {
+		return service.CalculateRequest{}, fmt.Errorf("invalid flags: %w", err)
+	}
+
+	// Prompts would be mixed with machine-readable output, so inputs could be set only by flags.
+	if missing := missingCalculateFlags(req); len(missing) != 0 && !isTextOutput(cmd) {
+		return service.CalculateRequest{}, missingFlagsError(cmd, missing)
+	}
+
+	if req.TaxType == "" || req.YearIncome == "" {
+		req, err = runTaxDetailsMenu(req)
+		if err != nil {
+			return service.CalculateRequest{}, fmt.Errorf("failed to collect tax details: %w", err)
+		}
+	}
+
+	if len(req.Income) == 0 {
+		incomes, err := runIncomeMenu()
+		if err != nil {
+			return service.CalculateRequest{}, fmt.Errorf("failed to collect incomes: %w", err)
+		}
+
+		req.Income = incomes
+	}
+
+	if category := strings.TrimSpace(cmd.String(flagCategory)); category != "" {
+		for i := range req.Income {
+			if req.Income[i].Category == "" {
+				req.Income[i].Category = category
+			}
+		}
+	}
+
+	return req, nil
+}
+
+// calculateRequestFromFlags fills service.CalculateRequest with values of flags that are set.
+func calculateRequestFromFlags(cmd *cli.Command) (service.CalculateRequest, error) {
+	var req service.CalculateRequest
+
+	if cmd.IsSet(flagTaxType) {
+		tt, err := taxes.ParseTaxType(cmd.String(flagTaxType))
+		if err != nil {
+			return service.CalculateRequest{}, fmt.Errorf("--%s: %w", flagTaxType, err)
+		}
+
+		req.TaxType = tt.String()
+	}
+
+	if cmd.IsSet(flagYearIncome) {
+		req.YearIncome = strings.TrimSpace(cmd.String(flagYearIncome))
+
+		if err := validateMoneyInput(req.YearIncome); err != nil {
+			return service.CalculateRequest{}, fmt.Errorf("--%s: %w", flagYearIncome, err)
+		}
+	}
+
+	if cmd.IsSet(flagIncomes) {
+		incomes, err := importer.ReadFile(cmd.String(flagIncomes))
+		if err != nil {
+			return service.CalculateRequest{}, fmt.Errorf("--%s: %w", flagIncomes, err)
+		}
+
+		req.Income = incomes
+	}
+
+	rounding, err := roundingFromFlags(cmd)
+	if err != nil {
+		return service.CalculateRequest{}, err
+	}
+
+	req.Rounding = rounding
+
+	return req, nil
+}
+
+// roundingFromFlags returns rounding of taxes set by flags, defaults are used for flags that are not set.
+func roundingFromFlags(cmd *cli.Command) (taxes.Rounding, error) {
+	policy, err := taxes.ParseRoundingPolicy(cmd.String(flagRounding))
+	if err != nil {
+		return taxes.Rounding{}, fmt.Errorf("--%s: %w", flagRounding, err)
+	}
+
+	mode, err := models.ParseRoundingMode(cmd.String(flagRoundingMode))
+	if err != nil {
+		return taxes.Rounding{}, fmt.Errorf("--%s: %w", flagRoundingMode, err)
+	}
+
+	return taxes.Rounding{Policy: policy, Mode: mode}, nil
+}
+
+// convertRequestFromFlags fills service.ConvertRequest with values of flags that are set.
+func convertRequestFromFlags(cmd *cli.Command) (service.ConvertRequest, error) {
+	var req service.ConvertRequest
+
+	if cmd.IsSet(flagDate) {
+		date, err := dateutils.ParseDate(cmd.String(flagDate))
+		if err != nil {
+			return service.ConvertRequest{}, fmt.Errorf("--%s: %w", flagDate, err)
+		}
+
+		req.DateRequest = service.NewDateRequest(date)
+	}
+
+	if cmd.IsSet(flagAmount) {
+		req.Amount = strings.TrimSpace(cmd.String(flagAmount))
+
+		if err := validateMoneyInput(req.Amount); err != nil {
+			return service.ConvertRequest{}, fmt.Errorf("--%s: %w", flagAmount, err)
+		}
+	}
+
+	currencyFlags := []struct {
+		name string
+		dst  *string
+	}{
+		{name: flagFrom, dst: &req.CurrencyFrom},
+		{name: flagTo, dst: &req.CurrencyTo},
+	}
+
+	for _, f := range currencyFlags {
+		if !cmd.IsSet(f.name) {
+			continue
+		}
+
+		val := cmd.String(f.name)
+
+		if err := validateCurrencyInput(val); err != nil {
+			return service.ConvertRequest{}, fmt.Errorf("--%s: %w", f.name, err)
+		}
+
+		*f.dst = strings.ToUpper(strings.TrimSpace(val))
+	}
+
+	return req, nil
+}
+
+// missingConvertFlags returns names of flags of conversion inputs that are not set.
+func missingConvertFlags(req service.ConvertRequest) []string {
+	var missing []string
+
+	if req.Year == "" || req.Month == "" || req.Day == "" {
+		missing = append(missing, flagDate)
+	}
+
+	if req.Amount == "" {
+		missing = append(missing, flagAmount)
+	}
+
+	if req.CurrencyFrom == "" {
+		missing = append(missing, flagFrom)
+	}
+
+	if req.CurrencyTo == "" {
+		missing = append(missing, flagTo)
+	}
+
+	return missing
+}
+
+// missingCalculateFlags returns names of flags of calculation inputs that are not set.
+func missingCalculateFlags(req service.CalculateRequest) []string {
+	var missing []string
+
+	if req.TaxType == "" {
+		missing = append(missing, flagTaxType)
+	}
+
+	if req.YearIncome == "" {
+		missing = append(missing, flagYearIncome)
+	}
+
+	if len(req.Income) == 0 {
+		missing = append(missing, flagIncomes)
+	}
+
+	return missing
+}
+
+// missingFlagsError returns error for flags that are required when inputs could not be prompted.
+func missingFlagsError(cmd *cli.Command, missing []string) error {
+	return fmt.Errorf("invalid flags: --%s required for %s output, as inputs are prompted only for %s output",
+		strings.Join(missing, ", --"), cmd.String(flagOutput), report.FormatText)
+}
+
+// printReport writes r to the output in format set by the --output flag.
+func printReport(cmd *cli.Command, r report.Report) error {
+	format, err := report.ParseFormat(cmd.String(flagOutput))
+	if err != nil {
+		return fmt.Errorf("--%s: %w", flagOutput, err)
+	}
+
+	w := cmd.Root().Writer
+
+	if format != report.FormatText {
+		return report.Write(w, format, r)
+	}
+
+	if _, err = fmt.Fprintln(w); err != nil {
+		return err
+	}
+
+	if err = report.Write(w, format, r); err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintln(w)
+
+	return err
+}
+
+// isTextOutput checks whether output is for humans, so decorations could be printed.
+func isTextOutput(cmd *cli.Command) bool {
+	format, err := report.ParseFormat(cmd.String(flagOutput))
+
+	return err != nil || format == report.FormatText
+}
@@ -3,6 +3,7 @@
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -10,6 +11,7 @@
 
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/shopspring/decimal"
 
 	"github.com/obalunenko/georgia-tax-calculator/internal/taxes"
 	"github.com/obalunenko/georgia-tax-calculator/pkg/dateutils"
@@ -276,7 +278,20 @@ func validateMoneyInput(val string) error {
 	}
 
 	if _, err := moneyutils.Parse(val); err != nil {
-		return fmt.Errorf("invalid amount: %w", err)
+		return err
+	}
+
+	return nil
+}
+
+func validateCurrencyInput(val string) error {
+	val = strings.TrimSpace(val)
+	if val == "" {
+		return errors.New("value is required")
+	}
+
+	if !slices.Contains(currencies.All(), strings.ToUpper(val)) {
+		return fmt.Errorf("unsupported currency: %s", val)
 	}
 
 	return nil
@@ -288,15 +303,11 @@ func taxTypeOptions() ([]option, error) {
 		return nil, err
 	}
 
-	sort.Slice(rates, func(i, j int) bool {
-		return rates[i].Type > rates[j].Type
-	})
-
-	const toPercentage float64 = 100
+	const toPercentage int64 = 100
 
 	opts := make([]option, len(rates))
 	for i := range rates {
-		m := moneyutils.Multiply(rates[i].Rate, toPercentage)
+		m := moneyutils.Multiply(moneyutils.FromFloat(rates[i].Rate), decimal.NewFromInt(toPercentage))
 		opts[i] = option{
 			Label:       rates[i].Type.String(),
 			Value:       rates[i].Type.String(),
@@ -11,8 +11,9 @@
 	"github.com/obalunenko/georgia-tax-calculator/pkg/nbggovge/currencies"
 )
 
-func runTaxDetailsMenu() (service.CalculateRequest, error) {
-	model := newTaxDetailsModel()
+// runTaxDetailsMenu asks for tax details that are not set in prefill.
+func runTaxDetailsMenu(prefill service.CalculateRequest) (service.CalculateRequest, error) {
+	model := newTaxDetailsModel(prefill)
 	if _, err := tea.NewProgram(model).Run(); err != nil {
 		return service.CalculateRequest{}, err
 	}
@@ -21,10 +22,10 @@ func runTaxDetailsMenu() (service.CalculateRequest, error) {
 		return service.CalculateRequest{}, model.err
 	}
 
-	return service.CalculateRequest{
-		TaxType:    model.taxType,
-		YearIncome: model.yearIncome,
-	}, nil
+	prefill.TaxType = model.taxType
+	prefill.YearIncome = model.yearIncome
+
+	return prefill, nil
 }
 
 func runIncomeMenu() ([]service.Income, error) {
@@ -56,7 +57,19 @@ type taxDetailsModel struct {
 	err        error
 }
 
-func newTaxDetailsModel() *taxDetailsModel {
+func newTaxDetailsModel(prefill service.CalculateRequest) *taxDetailsModel {
+	m := &taxDetailsModel{
+		taxType:    prefill.TaxType,
+		yearIncome: prefill.YearIncome,
+	}
+
+	if m.taxType != "" {
+		m.step = taxStepYearIncome
+		m.prompt = newYearIncomePrompt()
+
+		return m
+	}
+
 	opts, err := taxTypeOptions()
 	if err != nil {
 		return &taxDetailsModel{err: err}
@@ -65,10 +78,19 @@ func newTaxDetailsModel() *taxDetailsModel {
 	prompt := newSelectPrompt("Select your taxes type", opts, taxes.TaxTypeSmallBusiness.String())
 	prompt.SetNote("Bubble Tea UI • press Enter to confirm your selection.")
 
-	return &taxDetailsModel{
-		step:   taxStepTaxType,
-		prompt: prompt,
-	}
+	m.step = taxStepTaxType
+	m.prompt = prompt
+
+	return m
+}
+
+func newYearIncomePrompt() *promptModel {
+	return newInputPrompt(
+		"Income from the beginning of a calendar year (GEL)",
+		"0.00",
+		"",
+		validateMoneyInput,
+	)
 }
 
 func (m *taxDetailsModel) Init() tea.Cmd {
@@ -119,16 +141,16 @@ func (m *taxDetailsModel) advance() tea.Cmd {
 	switch m.step {
 	case taxStepTaxType:
 		m.taxType = m.prompt.Value()
-		m.step = taxStepYearIncome
 
-		prompt := newInputPrompt(
-			"Income from the beginning of a calendar year (GEL)",
-			"0.00",
-			"",
-			validateMoneyInput,
-		)
+		if m.yearIncome != "" {
+			m.step = taxStepDone
 
-		return m.setPrompt(prompt)
+			return tea.Quit
+		}
+
+		m.step = taxStepYearIncome
+
+		return m.setPrompt(newYearIncomePrompt())
 	case taxStepYearIncome:
 		m.yearIncome = m.prompt.Value()
 		m.step = taxStepDone
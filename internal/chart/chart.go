@@ -0,0 +1,338 @@
+// Package chart draws line charts of time series in terminal.
+package chart
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrNoPoints returned when there are no points to draw.
+	ErrNoPoints = errors.New("no points to draw")
+	// ErrInvalidSize returned when chart is too small to draw.
+	ErrInvalidSize = errors.New("invalid chart size")
+)
+
+const (
+	// DefaultWidth is a default number of columns of plot.
+	DefaultWidth = 60
+	// DefaultHeight is a default number of rows of plot.
+	DefaultHeight = 12
+
+	minWidth  = 2
+	minHeight = 2
+
+	dateLayout = time.DateOnly
+)
+
+// Glyphs of chart.
+const (
+	glyphMax     = '▲'
+	glyphMin     = '▼'
+	glyphMark    = '◆'
+	glyphFlat    = '─'
+	glyphVert    = '│'
+	glyphUpEnd   = '╯'
+	glyphUpStart = '╭'
+	glyphDnEnd   = '╮'
+	glyphDnStart = '╰'
+)
+
+// Point is a value of series at date.
+type Point struct {
+	Date  time.Time
+	Value float64
+}
+
+type config struct {
+	width     int
+	height    int
+	marks     []time.Time
+	markLabel string
+	from      time.Time
+	to        time.Time
+}
+
+// Option configures chart.
+type Option func(c *config)
+
+// WithSize sets number of columns and rows of plot. Defaults are DefaultWidth and DefaultHeight.
+func WithSize(width, height int) Option {
+	return func(c *config) {
+		c.width = width
+		c.height = height
+	}
+}
+
+// WithMarks marks dates under the plot, e.g. dates of incomes. Label describes marks in legend.
+func WithMarks(label string, dates []time.Time) Option {
+	return func(c *config) {
+		c.markLabel = label
+		c.marks = dates
+	}
+}
+
+// WithRange sets dates of the edges of plot, e.g. requested period of rates, so that marks
+// between them are drawn even when there are no points near them. Range is widened to include all points.
+func WithRange(from, to time.Time) Option {
+	return func(c *config) {
+		c.from = from
+		c.to = to
+	}
+}
+
+// Render draws line chart of points with title. Points are plotted by their dates,
+// value of a point holds until the next point, as rates are valid until the next publication.
+// Minimum and maximum points are marked and described under the chart.
+func Render(title string, points []Point, opts ...Option) (string, error) {
+	cfg := config{
+		width:  DefaultWidth,
+		height: DefaultHeight,
+	}
+
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	if len(points) == 0 {
+		return "", ErrNoPoints
+	}
+
+	if cfg.width < minWidth || cfg.height < minHeight {
+		return "", fmt.Errorf("%dx%d is smaller than %dx%d: %w", cfg.width, cfg.height, minWidth, minHeight, ErrInvalidSize)
+	}
+
+	points = slices.Clone(points)
+
+	slices.SortStableFunc(points, func(a, b Point) int {
+		return a.Date.Compare(b.Date)
+	})
+
+	p := newPlot(cfg, points)
+
+	var b strings.Builder
+
+	b.WriteString(title + "\n")
+
+	p.write(&b)
+
+	return strings.TrimSuffix(b.String(), "\n"), nil
+}
+
+// plot is a chart of points scaled to grid of width columns and height levels.
+type plot struct {
+	cfg    config
+	points []Point
+	from   time.Time
+	to     time.Time
+	min    Point
+	max    Point
+	// levels of columns from 0 at the bottom, -1 when column is before the first point.
+	levels []int
+}
+
+func newPlot(cfg config, points []Point) plot {
+	from, to := points[0].Date, points[len(points)-1].Date
+
+	if !cfg.from.IsZero() && cfg.from.Before(from) {
+		from = cfg.from
+	}
+
+	if cfg.to.After(to) {
+		to = cfg.to
+	}
+
+	p := plot{
+		cfg:    cfg,
+		points: points,
+		from:   from,
+		to:     to,
+		min:    points[0],
+		max:    points[0],
+		levels: make([]int, cfg.width),
+	}
+
+	for _, pt := range points {
+		if pt.Value < p.min.Value {
+			p.min = pt
+		}
+
+		if pt.Value > p.max.Value {
+			p.max = pt
+		}
+	}
+
+	for i := range p.levels {
+		p.levels[i] = -1
+	}
+
+	// Value of point holds until the column of the next point.
+	for i, pt := range points {
+		end := cfg.width
+
+		if i+1 < len(points) {
+			end = p.column(points[i+1].Date)
+		}
+
+		for col := p.column(pt.Date); col < end; col++ {
+			p.levels[col] = p.level(pt.Value)
+		}
+	}
+
+	return p
+}
+
+// column returns column of date.
+func (p plot) column(date time.Time) int {
+	span := p.to.Sub(p.from)
+	if span <= 0 {
+		return 0
+	}
+
+	return int(math.Round(float64(date.Sub(p.from)) / float64(span) * float64(p.cfg.width-1)))
+}
+
+// level returns level of value.
+func (p plot) level(v float64) int {
+	if p.max.Value == p.min.Value {
+		return (p.cfg.height - 1) / 2 //nolint:mnd // Flat line is drawn in the middle.
+	}
+
+	return int(math.Round((v - p.min.Value) / (p.max.Value - p.min.Value) * float64(p.cfg.height-1)))
+}
+
+// value returns value of level.
+func (p plot) value(level int) float64 {
+	if p.max.Value == p.min.Value {
+		return p.min.Value
+	}
+
+	return p.min.Value + (p.max.Value-p.min.Value)*float64(level)/float64(p.cfg.height-1)
+}
+
+// grid returns rows of plot from the top. Rows above the highest and below the lowest levels
+// hold markers of maximum and minimum.
+func (p plot) grid() [][]rune {
+	rows := make([][]rune, p.cfg.height+2) //nolint:mnd // Rows of markers.
+
+	for i := range rows {
+		rows[i] = []rune(strings.Repeat(" ", p.cfg.width))
+	}
+
+	row := func(level int) []rune {
+		return rows[p.cfg.height-level]
+	}
+
+	for col, lvl := range p.levels {
+		if lvl < 0 {
+			continue
+		}
+
+		prev := lvl
+		if col > 0 && p.levels[col-1] >= 0 {
+			prev = p.levels[col-1]
+		}
+
+		switch {
+		case prev == lvl:
+			row(lvl)[col] = glyphFlat
+		case prev < lvl:
+			row(prev)[col] = glyphUpEnd
+			row(lvl)[col] = glyphUpStart
+		default:
+			row(prev)[col] = glyphDnEnd
+			row(lvl)[col] = glyphDnStart
+		}
+
+		for l := min(prev, lvl) + 1; l < max(prev, lvl); l++ {
+			row(l)[col] = glyphVert
+		}
+	}
+
+	rows[0][p.column(p.max.Date)] = glyphMax
+	rows[len(rows)-1][p.column(p.min.Date)] = glyphMin
+
+	return rows
+}
+
+func (p plot) write(b *strings.Builder) {
+	labels := make([]string, p.cfg.height)
+	labelWidth := 0
+
+	for lvl := range labels {
+		// Flat line has the only value.
+		if p.max.Value == p.min.Value && lvl != p.level(p.min.Value) {
+			continue
+		}
+
+		labels[lvl] = fmt.Sprintf("%.4f", p.value(lvl))
+		labelWidth = max(labelWidth, len(labels[lvl]))
+	}
+
+	indent := strings.Repeat(" ", labelWidth)
+
+	for i, r := range p.grid() {
+		lvl := p.cfg.height - i
+
+		if lvl < 0 || lvl >= p.cfg.height {
+			b.WriteString(fmt.Sprintf("%s │%s\n", indent, strings.TrimRight(string(r), " ")))
+
+			continue
+		}
+
+		b.WriteString(fmt.Sprintf("%*s ┤%s\n", labelWidth, labels[lvl], strings.TrimRight(string(r), " ")))
+	}
+
+	b.WriteString(fmt.Sprintf("%s └%s\n", indent, strings.Repeat("─", p.cfg.width)))
+	b.WriteString(fmt.Sprintf("%s  %s\n", indent, p.dateAxis()))
+
+	marks := p.marks()
+	if marks != "" {
+		b.WriteString(fmt.Sprintf("%s  %s\n", indent, marks))
+	}
+
+	b.WriteString(fmt.Sprintf("%c Max: %.4f on %s\n", glyphMax, p.max.Value, p.max.Date.Format(dateLayout)))
+	b.WriteString(fmt.Sprintf("%c Min: %.4f on %s\n", glyphMin, p.min.Value, p.min.Date.Format(dateLayout)))
+
+	if marks != "" {
+		b.WriteString(fmt.Sprintf("%c %s\n", glyphMark, p.cfg.markLabel))
+	}
+}
+
+// dateAxis returns the first and the last dates aligned to the edges of plot.
+func (p plot) dateAxis() string {
+	from := p.from.Format(dateLayout)
+	to := p.to.Format(dateLayout)
+
+	if p.from.Equal(p.to) || p.cfg.width < len(from)+len(to)+1 {
+		return from
+	}
+
+	return from + strings.Repeat(" ", p.cfg.width-len(from)-len(to)) + to
+}
+
+// marks returns row with marks of dates in range of plot, empty when there are no such dates.
+func (p plot) marks() string {
+	row := []rune(strings.Repeat(" ", p.cfg.width))
+
+	var found bool
+
+	for _, d := range p.cfg.marks {
+		if d.Before(p.from) || d.After(p.to) {
+			continue
+		}
+
+		row[p.column(d)] = glyphMark
+		found = true
+	}
+
+	if !found {
+		return ""
+	}
+
+	return strings.TrimRight(string(row), " ")
+}
@@ -0,0 +1,118 @@
+package chart
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func date(month time.Month, day int) time.Time {
+	return time.Date(2024, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestRender(t *testing.T) {
+	points := []Point{
+		{Date: date(time.January, 1), Value: 2},
+		{Date: date(time.January, 4), Value: 3},
+		{Date: date(time.January, 7), Value: 1},
+		{Date: date(time.January, 10), Value: 2},
+	}
+
+	tests := []struct {
+		name   string
+		points []Point
+		opts   []Option
+		want   string
+	}{
+		{
+			name:   "line with min and max",
+			points: points,
+			opts:   []Option{WithSize(22, 3)},
+			want: "USD/GEL\n" +
+				"       │       ▲\n" +
+				"3.0000 ┤       ╭──────╮\n" +
+				"2.0000 ┤───────╯      │      ╭\n" +
+				"1.0000 ┤              ╰──────╯\n" +
+				"       │              ▼\n" +
+				"       └──────────────────────\n" +
+				"        2024-01-01  2024-01-10\n" +
+				"▲ Max: 3.0000 on 2024-01-04\n" +
+				"▼ Min: 1.0000 on 2024-01-07",
+		},
+		{
+			name:   "unsorted points with marks",
+			points: []Point{points[3], points[1], points[0], points[2]},
+			opts: []Option{
+				WithSize(22, 3),
+				WithMarks("Incomes", []time.Time{date(time.January, 2), date(time.January, 10), date(time.February, 1)}),
+			},
+			want: "USD/GEL\n" +
+				"       │       ▲\n" +
+				"3.0000 ┤       ╭──────╮\n" +
+				"2.0000 ┤───────╯      │      ╭\n" +
+				"1.0000 ┤              ╰──────╯\n" +
+				"       │              ▼\n" +
+				"       └──────────────────────\n" +
+				"        2024-01-01  2024-01-10\n" +
+				"          ◆                  ◆\n" +
+				"▲ Max: 3.0000 on 2024-01-04\n" +
+				"▼ Min: 1.0000 on 2024-01-07\n" +
+				"◆ Incomes",
+		},
+		{
+			name:   "range wider than points with marks",
+			points: points[1:],
+			opts: []Option{
+				WithSize(22, 3),
+				WithRange(date(time.January, 1), date(time.January, 22)),
+				WithMarks("Incomes", []time.Time{date(time.January, 2), date(time.January, 15), date(time.February, 1)}),
+			},
+			want: "USD/GEL\n" +
+				"       │   ▲\n" +
+				"3.0000 ┤   ───╮\n" +
+				"2.0000 ┤      │  ╭────────────\n" +
+				"1.0000 ┤      ╰──╯\n" +
+				"       │      ▼\n" +
+				"       └──────────────────────\n" +
+				"        2024-01-01  2024-01-22\n" +
+				"         ◆            ◆\n" +
+				"▲ Max: 3.0000 on 2024-01-04\n" +
+				"▼ Min: 1.0000 on 2024-01-07\n" +
+				"◆ Incomes",
+		},
+		{
+			name:   "single point",
+			points: []Point{{Date: date(time.January, 1), Value: 2.5}},
+			opts:   []Option{WithSize(5, 3)},
+			want: "USD/GEL\n" +
+				"       │▲\n" +
+				"       ┤\n" +
+				"2.5000 ┤─────\n" +
+				"       ┤\n" +
+				"       │▼\n" +
+				"       └─────\n" +
+				"        2024-01-01\n" +
+				"▲ Max: 2.5000 on 2024-01-01\n" +
+				"▼ Min: 2.5000 on 2024-01-01",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Render("USD/GEL", tt.points, tt.opts...)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRender_Errors(t *testing.T) {
+	_, err := Render("USD/GEL", nil)
+	require.ErrorIs(t, err, ErrNoPoints)
+
+	_, err = Render("USD/GEL", []Point{{Date: date(time.January, 1), Value: 1}}, WithSize(1, 10))
+	require.ErrorIs(t, err, ErrInvalidSize)
+}
@@ -5,16 +5,59 @@
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
+	"github.com/shopspring/decimal"
+
 	"github.com/obalunenko/georgia-tax-calculator/internal/models"
 	"github.com/obalunenko/georgia-tax-calculator/pkg/moneyutils"
 	"github.com/obalunenko/georgia-tax-calculator/pkg/nbggovge"
+	"github.com/obalunenko/georgia-tax-calculator/pkg/nbggovge/currencies"
 	"github.com/obalunenko/georgia-tax-calculator/pkg/nbggovge/option"
 )
 
-// ErrCurrencyNotSet returned when currency code for conversion not set.
-var ErrCurrencyNotSet = errors.New("currency not set")
+var (
+	// ErrCurrencyNotSet returned when currency code for conversion not set.
+	ErrCurrencyNotSet = errors.New("currency not set")
+	// ErrNoRate returned when no rate is published for requested date according to RatePolicy.
+	ErrNoRate = errors.New("no rate published")
+)
+
+// NoRatesError is returned when no rate is published for requested date according to RatePolicy.
+// It matches ErrNoRate with errors.Is.
+type NoRatesError struct {
+	// Date is the requested date.
+	Date time.Time
+	// FallbackDays is a number of days before Date that were checked for a rate.
+	FallbackDays int
+}
+
+func (e *NoRatesError) Error() string {
+	if e.FallbackDays == 0 {
+		return fmt.Sprintf("%s: %v", e.Date.Format(time.DateOnly), ErrNoRate)
+	}
+
+	return fmt.Sprintf("%s and %d days before: %v", e.Date.Format(time.DateOnly), e.FallbackDays, ErrNoRate)
+}
+
+func (e *NoRatesError) Is(target error) bool {
+	return target == ErrNoRate
+}
+
+// RatePolicy defines which rate is used when no rate is published for requested date,
+// e.g. on weekends and holidays.
+type RatePolicy uint
+
+const (
+	// RatePolicyPrevious uses the latest rate published before requested date.
+	RatePolicyPrevious RatePolicy = iota
+	// RatePolicyExact fails with NoRatesError when rate is not published for requested date.
+	RatePolicyExact
+)
+
+// maxFallbackDays limits how many days before requested date RatePolicyPrevious looks for a rate.
+const maxFallbackDays = 14
 
 // Converter is a converter of money from one currency to another.
 type Converter interface {
@@ -23,17 +66,36 @@ type Converter interface {
 
 type converter struct {
 	client nbggovge.Client
+	policy RatePolicy
+}
+
+// Option configures Converter.
+type Option func(c *converter)
+
+// WithRatePolicy sets RatePolicy of Converter. Default is RatePolicyPrevious.
+func WithRatePolicy(p RatePolicy) Option {
+	return func(c *converter) {
+		c.policy = p
+	}
 }
 
 // NewConverter constructor.
-func NewConverter(client nbggovge.Client) Converter {
-	return &converter{client: client}
+func NewConverter(client nbggovge.Client, opts ...Option) Converter {
+	c := &converter{client: client}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
 }
 
 // Response of conversion.
 type Response struct {
 	models.Money
-	Rate float64
+	Rate decimal.Decimal
+	// RateDate is a date since the rate is valid. It could be earlier than requested date.
+	RateDate time.Time
 }
 
 // Convert converts amount from currency to with rates according to passed date.
@@ -46,17 +108,7 @@ func (c converter) Convert(ctx context.Context, m models.Money, to string, date
 		return Response{}, fmt.Errorf("to: %w", ErrCurrencyNotSet)
 	}
 
-	rates, err := c.client.Rates(ctx, option.WithDate(date), option.WithCurrency(m.Currency), option.WithCurrency(to))
-	if err != nil {
-		return Response{}, err
-	}
-
-	fromCurrency, err := c.getCurrencyRates(m.Currency, rates)
-	if err != nil {
-		return Response{}, err
-	}
-
-	toCurrency, err := c.getCurrencyRates(to, rates)
+	fromCurrency, toCurrency, rateDate, err := c.rates(ctx, m.Currency, to, date)
 	if err != nil {
 		return Response{}, err
 	}
@@ -65,30 +117,120 @@ func (c converter) Convert(ctx context.Context, m models.Money, to string, date
 	// rate = (fromCurrency.Rate / fromCurrency.Quantity) / (toCurrency.Rate / toCurrency.Quantity).
 
 	// Dividing fromCurrency's rate by its quantity.
-	divFrom := moneyutils.Div(fromCurrency.Rate, float64(fromCurrency.Quantity))
+	divFrom := moneyutils.Div(moneyutils.FromFloat(fromCurrency.Rate), decimal.NewFromInt(int64(fromCurrency.Quantity)))
 
 	// Dividing toCurrency's rate by its quantity.
-	divTo := moneyutils.Div(toCurrency.Rate, float64(toCurrency.Quantity))
+	divTo := moneyutils.Div(moneyutils.FromFloat(toCurrency.Rate), decimal.NewFromInt(int64(toCurrency.Quantity)))
 
 	// Calculating the rate by dividing divFrom by divTo according to the formula.
 	rate := moneyutils.Div(divFrom, divTo)
 
-	convertedAmount := moneyutils.Multiply(m.Amount, rate)
+	converted := models.NewMoney(m.Mul(rate).Amount, to)
 
-	const (
-		amountPlaces int32 = 2
-		ratePlaces   int32 = 4
-	)
+	const ratePlaces int32 = 4
 
 	return Response{
-		Money: models.Money{
-			Amount:   moneyutils.Round(convertedAmount, amountPlaces),
-			Currency: to,
-		},
-		Rate: moneyutils.Round(rate, ratePlaces),
+		Money:    converted.Round(),
+		Rate:     moneyutils.Normalize(moneyutils.Round(rate, ratePlaces)),
+		RateDate: rateDate,
 	}, nil
 }
 
+// rates returns rates of currencies valid for date according to RatePolicy along with the date since they are valid.
+func (c converter) rates(ctx context.Context, from, to string, date time.Time) (nbggovge.Currency, nbggovge.Currency, time.Time, error) {
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
+
+	for i := 0; i <= maxFallbackDays; i++ {
+		d := day.AddDate(0, 0, -i)
+
+		rates, err := c.client.Rates(ctx, option.WithDate(d), option.WithCurrency(from), option.WithCurrency(to))
+		if err != nil {
+			return nbggovge.Currency{}, nbggovge.Currency{}, time.Time{}, err
+		}
+
+		rateDate, ok, err := c.validFrom(rates, from, to, d)
+		if err != nil {
+			return nbggovge.Currency{}, nbggovge.Currency{}, time.Time{}, err
+		}
+
+		if c.policy == RatePolicyExact && (!ok || !rateDate.Equal(day)) {
+			return nbggovge.Currency{}, nbggovge.Currency{}, time.Time{},
+				&NoRatesError{Date: day}
+		}
+
+		if !ok {
+			continue
+		}
+
+		fromCurrency, err := c.getCurrencyRates(from, rates)
+		if err != nil {
+			return nbggovge.Currency{}, nbggovge.Currency{}, time.Time{}, err
+		}
+
+		toCurrency, err := c.getCurrencyRates(to, rates)
+		if err != nil {
+			return nbggovge.Currency{}, nbggovge.Currency{}, time.Time{}, err
+		}
+
+		return fromCurrency, toCurrency, rateDate, nil
+	}
+
+	return nbggovge.Currency{}, nbggovge.Currency{}, time.Time{},
+		&NoRatesError{Date: day, FallbackDays: maxFallbackDays}
+}
+
+// validFrom returns the date since rates of currencies are valid.
+// ok is false when rates are not published for date, then earlier date should be checked.
+func (c converter) validFrom(rates nbggovge.Rates, from, to string, date time.Time) (time.Time, bool, error) {
+	validFrom := date
+	published := false
+
+	for _, code := range []string{from, to} {
+		// GEL rate is always 1 and is not published.
+		if strings.EqualFold(code, currencies.GEL) {
+			continue
+		}
+
+		currency, err := rates.CurrencyByCode(code)
+		if err != nil {
+			if hasPublishedRates(rates) {
+				return time.Time{}, false, err
+			}
+
+			return time.Time{}, false, nil
+		}
+
+		vf, err := currency.ValidFrom()
+		if err != nil {
+			return time.Time{}, false, err
+		}
+
+		// Rates valid from the later date are not published yet for requested date.
+		if vf.After(date) {
+			return time.Time{}, false, nil
+		}
+
+		if !published || vf.After(validFrom) {
+			validFrom = vf
+		}
+
+		published = true
+	}
+
+	return time.Date(validFrom.Year(), validFrom.Month(), validFrom.Day(), 0, 0, 0, 0, date.Location()), true, nil
+}
+
+// hasPublishedRates reports whether rates have any currency except GEL, that is added to every response.
+func hasPublishedRates(rates nbggovge.Rates) bool {
+	for _, c := range rates.Currencies {
+		if !strings.EqualFold(c.Code, currencies.GEL) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (c converter) getCurrencyRates(code string, rates nbggovge.Rates) (nbggovge.Currency, error) {
 	currency, err := rates.CurrencyByCode(code)
 	if err != nil {
@@ -9,6 +9,7 @@
 	"testing"
 	"time"
 
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -29,8 +30,14 @@ func newMockRatesClient(t testing.TB) nbggovge.Client {
 	return mock.NewClient(resp)
 }
 
+func money(amount, currency string) models.Money {
+	return models.NewMoney(decimal.RequireFromString(amount), currency)
+}
+
 func TestConverter_Convert(t *testing.T) {
 	ctx := context.Background()
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
 
 	type fields struct {
 		client nbggovge.Client
@@ -56,20 +63,15 @@ type args struct {
 				client: newMockRatesClient(t),
 			},
 			args: args{
-				ctx: ctx,
-				m: models.Money{
-					Amount:   2_678.27,
-					Currency: currencies.EUR,
-				},
+				ctx:  ctx,
+				m:    money("2678.27", currencies.EUR),
 				to:   currencies.GEL,
-				date: time.Now(),
+				date: today,
 			},
 			want: Response{
-				Money: models.Money{
-					Amount:   7521.39,
-					Currency: currencies.GEL,
-				},
-				Rate: 2.8083,
+				Money:    money("7521.39", currencies.GEL),
+				Rate:     decimal.RequireFromString("2.8083"),
+				RateDate: today,
 			},
 			wantErr: assert.NoError,
 		},
@@ -79,20 +81,15 @@ type args struct {
 				client: newMockRatesClient(t),
 			},
 			args: args{
-				ctx: ctx,
-				m: models.Money{
-					Amount:   2_678.27,
-					Currency: currencies.EUR,
-				},
+				ctx:  ctx,
+				m:    money("2678.27", currencies.EUR),
 				to:   currencies.EUR,
-				date: time.Now(),
+				date: today,
 			},
 			want: Response{
-				Money: models.Money{
-					Amount:   2_678.27,
-					Currency: currencies.EUR,
-				},
-				Rate: 1,
+				Money:    money("2678.27", currencies.EUR),
+				Rate:     decimal.RequireFromString("1"),
+				RateDate: today,
 			},
 			wantErr: assert.NoError,
 		},
@@ -102,20 +99,51 @@ type args struct {
 				client: newMockRatesClient(t),
 			},
 			args: args{
-				ctx: ctx,
-				m: models.Money{
-					Amount:   2_678.27,
-					Currency: currencies.EUR,
-				},
+				ctx:  ctx,
+				m:    money("2678.27", currencies.EUR),
 				to:   currencies.GBP,
-				date: time.Now(),
+				date: today,
 			},
 			want: Response{
-				Money: models.Money{
-					Amount:   2_297.45,
-					Currency: currencies.GBP,
-				},
-				Rate: 0.8578,
+				Money:    money("2297.45", currencies.GBP),
+				Rate:     decimal.RequireFromString("0.8578"),
+				RateDate: today,
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "EUR - JPY",
+			fields: fields{
+				client: newMockRatesClient(t),
+			},
+			args: args{
+				ctx:  ctx,
+				m:    money("2678.27", currencies.EUR),
+				to:   currencies.JPY,
+				date: today,
+			},
+			want: Response{
+				Money:    money("422241", currencies.JPY),
+				Rate:     decimal.RequireFromString("157.6545"),
+				RateDate: today,
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "EUR - KWD",
+			fields: fields{
+				client: newMockRatesClient(t),
+			},
+			args: args{
+				ctx:  ctx,
+				m:    money("2678.27", currencies.EUR),
+				to:   currencies.KWD,
+				date: today,
+			},
+			want: Response{
+				Money:    money("884.193", currencies.KWD),
+				Rate:     decimal.RequireFromString("0.3301"),
+				RateDate: today,
 			},
 			wantErr: assert.NoError,
 		},
@@ -125,13 +153,10 @@ type args struct {
 				client: newMockRatesClient(t),
 			},
 			args: args{
-				ctx: ctx,
-				m: models.Money{
-					Amount:   2_678.27,
-					Currency: "",
-				},
+				ctx:  ctx,
+				m:    money("2678.27", ""),
 				to:   currencies.GBP,
-				date: time.Now(),
+				date: today,
 			},
 			want:    Response{},
 			wantErr: assert.Error,
@@ -142,13 +167,10 @@ type args struct {
 				client: newMockRatesClient(t),
 			},
 			args: args{
-				ctx: ctx,
-				m: models.Money{
-					Amount:   2_678.27,
-					Currency: currencies.EUR,
-				},
+				ctx:  ctx,
+				m:    money("2678.27", currencies.EUR),
 				to:   "",
-				date: time.Now(),
+				date: today,
 			},
 			want:    Response{},
 			wantErr: assert.Error,
@@ -159,20 +181,15 @@ type args struct {
 				client: newMockRatesClient(t),
 			},
 			args: args{
-				ctx: ctx,
-				m: models.Money{
-					Amount:   2_678.27,
-					Currency: currencies.GEL,
-				},
+				ctx:  ctx,
+				m:    money("2678.27", currencies.GEL),
 				to:   currencies.GEL,
-				date: time.Now(),
+				date: today,
 			},
 			want: Response{
-				Money: models.Money{
-					Amount:   2_678.27,
-					Currency: currencies.GEL,
-				},
-				Rate: 1,
+				Money:    money("2678.27", currencies.GEL),
+				Rate:     decimal.RequireFromString("1"),
+				RateDate: today,
 			},
 			wantErr: assert.NoError,
 		},
@@ -182,20 +199,15 @@ type args struct {
 				client: newMockRatesClient(t),
 			},
 			args: args{
-				ctx: ctx,
-				m: models.Money{
-					Amount:   2_678.27,
-					Currency: currencies.PLN,
-				},
+				ctx:  ctx,
+				m:    money("2678.27", currencies.PLN),
 				to:   currencies.GEL,
-				date: time.Now(),
+				date: today,
 			},
 			want: Response{
-				Money: models.Money{
-					Amount:   1_625.9,
-					Currency: currencies.GEL,
-				},
-				Rate: 0.6071,
+				Money:    money("1625.9", currencies.GEL),
+				Rate:     decimal.RequireFromString("0.6071"),
+				RateDate: today,
 			},
 			wantErr: assert.NoError,
 		},
@@ -205,20 +217,15 @@ type args struct {
 				client: newMockRatesClient(t),
 			},
 			args: args{
-				ctx: ctx,
-				m: models.Money{
-					Amount:   2_678.27,
-					Currency: currencies.BYN,
-				},
+				ctx:  ctx,
+				m:    money("2678.27", currencies.BYN),
 				to:   currencies.GEL,
-				date: time.Now(),
+				date: today,
 			},
 			want: Response{
-				Money: models.Money{
-					Amount:   2_792.1,
-					Currency: currencies.GEL,
-				},
-				Rate: 1.0425,
+				Money:    money("2792.1", currencies.GEL),
+				Rate:     decimal.RequireFromString("1.0425"),
+				RateDate: today,
 			},
 			wantErr: assert.NoError,
 		},
@@ -228,20 +235,15 @@ type args struct {
 				client: newMockRatesClient(t),
 			},
 			args: args{
-				ctx: ctx,
-				m: models.Money{
-					Amount:   2_678.27,
-					Currency: currencies.BYN,
-				},
+				ctx:  ctx,
+				m:    money("2678.27", currencies.BYN),
 				to:   currencies.PLN,
-				date: time.Now(),
+				date: today,
 			},
 			want: Response{
-				Money: models.Money{
-					Amount:   4_599.3,
-					Currency: currencies.PLN,
-				},
-				Rate: 1.7173,
+				Money:    money("4599.3", currencies.PLN),
+				Rate:     decimal.RequireFromString("1.7173"),
+				RateDate: today,
 			},
 			wantErr: assert.NoError,
 		},
@@ -251,20 +253,15 @@ type args struct {
 				client: newMockRatesClient(t),
 			},
 			args: args{
-				ctx: ctx,
-				m: models.Money{
-					Amount:   2_678.27,
-					Currency: currencies.PLN,
-				},
+				ctx:  ctx,
+				m:    money("2678.27", currencies.PLN),
 				to:   currencies.BYN,
-				date: time.Now(),
+				date: today,
 			},
 			want: Response{
-				Money: models.Money{
-					Amount:   1_559.61,
-					Currency: currencies.BYN,
-				},
-				Rate: 0.5823,
+				Money:    money("1559.61", currencies.BYN),
+				Rate:     decimal.RequireFromString("0.5823"),
+				RateDate: today,
 			},
 			wantErr: assert.NoError,
 		},
@@ -274,20 +271,15 @@ type args struct {
 				client: newMockRatesClient(t),
 			},
 			args: args{
-				ctx: ctx,
-				m: models.Money{
-					Amount:   50_000,
-					Currency: currencies.RUB,
-				},
+				ctx:  ctx,
+				m:    money("50000", currencies.RUB),
 				to:   currencies.EUR,
-				date: time.Now(),
+				date: today,
 			},
 			want: Response{
-				Money: models.Money{
-					Amount:   477.74,
-					Currency: currencies.EUR,
-				},
-				Rate: 0.0096,
+				Money:    money("477.74", currencies.EUR),
+				Rate:     decimal.RequireFromString("0.0096"),
+				RateDate: today,
 			},
 			wantErr: assert.NoError,
 		},
@@ -297,20 +289,15 @@ type args struct {
 				client: newMockRatesClient(t),
 			},
 			args: args{
-				ctx: ctx,
-				m: models.Money{
-					Amount:   550,
-					Currency: currencies.EUR,
-				},
+				ctx:  ctx,
+				m:    money("550", currencies.EUR),
 				to:   currencies.RUB,
-				date: time.Now(),
+				date: today,
 			},
 			want: Response{
-				Money: models.Money{
-					Amount:   57_562.14,
-					Currency: currencies.RUB,
-				},
-				Rate: 104.6584,
+				Money:    money("57562.14", currencies.RUB),
+				Rate:     decimal.RequireFromString("104.6584"),
+				RateDate: today,
 			},
 			wantErr: assert.NoError,
 		},
@@ -320,20 +307,15 @@ type args struct {
 				client: newMockRatesClient(t),
 			},
 			args: args{
-				ctx: ctx,
-				m: models.Money{
-					Amount:   50_000,
-					Currency: currencies.RUB,
-				},
+				ctx:  ctx,
+				m:    money("50000", currencies.RUB),
 				to:   currencies.GEL,
-				date: time.Now(),
+				date: today,
 			},
 			want: Response{
-				Money: models.Money{
-					Amount:   1_341.65,
-					Currency: currencies.GEL,
-				},
-				Rate: 0.0268,
+				Money:    money("1341.65", currencies.GEL),
+				Rate:     decimal.RequireFromString("0.0268"),
+				RateDate: today,
 			},
 			wantErr: assert.NoError,
 		},
@@ -343,20 +325,15 @@ type args struct {
 				client: newMockRatesClient(t),
 			},
 			args: args{
-				ctx: ctx,
-				m: models.Money{
-					Amount:   50_000,
-					Currency: currencies.RUB,
-				},
+				ctx:  ctx,
+				m:    money("50000", currencies.RUB),
 				to:   currencies.TMT,
-				date: time.Now(),
+				date: today,
 			},
 			want: Response{
-				Money: models.Money{
-					Amount:   1_788.8,
-					Currency: currencies.TMT,
-				},
-				Rate: 0.0358,
+				Money:    money("1788.8", currencies.TMT),
+				Rate:     decimal.RequireFromString("0.0358"),
+				RateDate: today,
 			},
 			wantErr: assert.NoError,
 		},
@@ -366,20 +343,15 @@ type args struct {
 				client: newMockRatesClient(t),
 			},
 			args: args{
-				ctx: ctx,
-				m: models.Money{
-					Amount:   1_787.95,
-					Currency: currencies.TMT,
-				},
+				ctx:  ctx,
+				m:    money("1787.95", currencies.TMT),
 				to:   currencies.RUB,
-				date: time.Now(),
+				date: today,
 			},
 			want: Response{
-				Money: models.Money{
-					Amount:   49976.38,
-					Currency: currencies.RUB,
-				},
-				Rate: 27.9518,
+				Money:    money("49976.38", currencies.RUB),
+				Rate:     decimal.RequireFromString("27.9518"),
+				RateDate: today,
 			},
 			wantErr: assert.NoError,
 		},
@@ -412,3 +384,186 @@ type args struct {
 		})
 	}
 }
+
+func TestConverter_Convert_RatePolicy(t *testing.T) {
+	usd := func(date, validFrom string, rate float64) nbggovge.Rates {
+		return nbggovge.Rates{
+			Date: date + "T00:00:00.000Z",
+			Currencies: []nbggovge.Currency{
+				{
+					Code:          currencies.USD,
+					Quantity:      1,
+					Rate:          rate,
+					Date:          date + "T17:45:00.000Z",
+					ValidFromDate: validFrom + "T00:00:00.000Z",
+				},
+			},
+		}
+	}
+
+	// 2023-09-09 and 2023-09-10 are weekend days without published rates.
+	// Rates of 2023-09-11 are valid from 2023-09-09, as API returns them for a weekend.
+	client := mock.NewHistoryClient([]nbggovge.Rates{
+		usd("2023-09-08", "2023-09-08", 2.5),
+		usd("2023-09-11", "2023-09-09", 2.6),
+	})
+
+	date := func(day int) time.Time {
+		return time.Date(2023, time.September, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name    string
+		policy  RatePolicy
+		from    string
+		date    time.Time
+		want    Response
+		wantErr error
+	}{
+		{
+			name:   "previous - published",
+			policy: RatePolicyPrevious,
+			from:   currencies.USD,
+			date:   date(8),
+			want: Response{
+				Money:    money("250", currencies.GEL),
+				Rate:     decimal.RequireFromString("2.5"),
+				RateDate: date(8),
+			},
+		},
+		{
+			name:   "previous - not published",
+			policy: RatePolicyPrevious,
+			from:   currencies.USD,
+			date:   date(10),
+			want: Response{
+				Money:    money("250", currencies.GEL),
+				Rate:     decimal.RequireFromString("2.5"),
+				RateDate: date(8),
+			},
+		},
+		{
+			name:   "previous - valid from earlier date",
+			policy: RatePolicyPrevious,
+			from:   currencies.USD,
+			date:   date(11),
+			want: Response{
+				Money:    money("260", currencies.GEL),
+				Rate:     decimal.RequireFromString("2.6"),
+				RateDate: date(9),
+			},
+		},
+		{
+			name:    "previous - nothing published before",
+			policy:  RatePolicyPrevious,
+			from:    currencies.USD,
+			date:    time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC),
+			wantErr: ErrNoRate,
+		},
+		{
+			name:    "previous - unknown currency",
+			policy:  RatePolicyPrevious,
+			from:    currencies.EUR,
+			date:    date(8),
+			wantErr: nbggovge.ErrCodeNotFound,
+		},
+		{
+			name:   "exact - published",
+			policy: RatePolicyExact,
+			from:   currencies.USD,
+			date:   date(8),
+			want: Response{
+				Money:    money("250", currencies.GEL),
+				Rate:     decimal.RequireFromString("2.5"),
+				RateDate: date(8),
+			},
+		},
+		{
+			name:    "exact - not published",
+			policy:  RatePolicyExact,
+			from:    currencies.USD,
+			date:    date(10),
+			wantErr: ErrNoRate,
+		},
+		{
+			name:    "exact - valid from earlier date",
+			policy:  RatePolicyExact,
+			from:    currencies.USD,
+			date:    date(11),
+			wantErr: ErrNoRate,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewConverter(client, WithRatePolicy(tt.policy))
+
+			got, err := c.Convert(context.Background(), money("100", tt.from), currencies.GEL, tt.date)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestConverter_Convert_TypedErrors(t *testing.T) {
+	client := mock.NewHistoryClient([]nbggovge.Rates{
+		{
+			Date: "2023-09-08T00:00:00.000Z",
+			Currencies: []nbggovge.Currency{
+				{
+					Code:          currencies.USD,
+					Quantity:      1,
+					Rate:          2.5,
+					Date:          "2023-09-08T17:45:00.000Z",
+					ValidFromDate: "2023-09-08T00:00:00.000Z",
+				},
+			},
+		},
+	})
+
+	ctx := context.Background()
+
+	t.Run("no rates", func(t *testing.T) {
+		date := time.Date(2023, time.September, 10, 0, 0, 0, 0, time.UTC)
+
+		_, err := NewConverter(client, WithRatePolicy(RatePolicyExact)).
+			Convert(ctx, money("100", currencies.USD), currencies.GEL, date)
+
+		var nerr *NoRatesError
+
+		require.ErrorAs(t, err, &nerr)
+		assert.Equal(t, date, nerr.Date)
+		assert.Equal(t, 0, nerr.FallbackDays)
+		assert.EqualError(t, err, "2023-09-10: no rate published")
+	})
+
+	t.Run("no rates with fallback", func(t *testing.T) {
+		date := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+		_, err := NewConverter(client).Convert(ctx, money("100", currencies.USD), currencies.GEL, date)
+
+		var nerr *NoRatesError
+
+		require.ErrorAs(t, err, &nerr)
+		assert.Equal(t, date, nerr.Date)
+		assert.Equal(t, maxFallbackDays, nerr.FallbackDays)
+	})
+
+	t.Run("unknown currency", func(t *testing.T) {
+		date := time.Date(2023, time.September, 8, 0, 0, 0, 0, time.UTC)
+
+		_, err := NewConverter(client).Convert(ctx, money("100", currencies.EUR), currencies.GEL, date)
+
+		var uerr *nbggovge.UnknownCurrencyError
+
+		require.ErrorAs(t, err, &uerr)
+		assert.Equal(t, currencies.EUR, uerr.Code)
+		assert.Equal(t, date, uerr.Date)
+	})
+}
@@ -0,0 +1,391 @@
+// Package importer provides functionality for reading incomes from CSV and JSON files.
+package importer
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/obalunenko/georgia-tax-calculator/internal/service"
+	"github.com/obalunenko/georgia-tax-calculator/pkg/dateutils"
+	"github.com/obalunenko/georgia-tax-calculator/pkg/moneyutils"
+	"github.com/obalunenko/georgia-tax-calculator/pkg/nbggovge/currencies"
+)
+
+var (
+	// ErrUnsupportedFormat returned when format of incomes file is not supported.
+	ErrUnsupportedFormat = errors.New("unsupported format")
+	// ErrNoIncomes returned when incomes file has no rows.
+	ErrNoIncomes = errors.New("no incomes")
+	// ErrInvalidRow returned when row of incomes file could not be parsed.
+	ErrInvalidRow = errors.New("invalid row")
+)
+
+// Format of incomes file.
+type Format string
+
+const (
+	// FormatCSV is a CSV file with date, currency, amount and optional category columns.
+	// The first row may be a header with column names in any order.
+	FormatCSV Format = "csv"
+	// FormatJSON is a JSON array of objects with date, currency, amount and optional category fields.
+	FormatJSON Format = "json"
+)
+
+const (
+	columnDate     = "date"
+	columnCurrency = "currency"
+	columnAmount   = "amount"
+	// columnCategory is optional.
+	columnCategory = "category"
+)
+
+// RowError is an error of a single row of incomes file.
+type RowError struct {
+	// Line number in the file starting from 1.
+	Line int
+	Err  error
+}
+
+func (e *RowError) Error() string {
+	return fmt.Sprintf("line %d: %v", e.Line, e.Err)
+}
+
+func (e *RowError) Unwrap() error {
+	return e.Err
+}
+
+// ReadFile reads incomes from file at path. Format is detected by file extension.
+func ReadFile(path string) ([]service.Income, error) {
+	format := Format(strings.ToLower(strings.TrimPrefix(filepath.Ext(path), ".")))
+
+	f, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return nil, fmt.Errorf("open incomes file: %w", err)
+	}
+
+	defer func() {
+		_ = f.Close()
+	}()
+
+	return Read(f, format)
+}
+
+// Read reads incomes in format from r.
+// All invalid rows are reported in returned error as RowError joined together.
+func Read(r io.Reader, format Format) ([]service.Income, error) {
+	var (
+		rows []row
+		err  error
+	)
+
+	switch format {
+	case FormatCSV:
+		rows, err = readCSV(r)
+	case FormatJSON:
+		rows, err = readJSON(r)
+	default:
+		return nil, fmt.Errorf("%q: %w", format, ErrUnsupportedFormat)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rows) == 0 {
+		return nil, ErrNoIncomes
+	}
+
+	incomes := make([]service.Income, 0, len(rows))
+
+	var errs []error
+
+	for _, rw := range rows {
+		inc, err := rw.income()
+		if err != nil {
+			errs = append(errs, &RowError{Line: rw.line, Err: err})
+
+			continue
+		}
+
+		incomes = append(incomes, inc)
+	}
+
+	if len(errs) != 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	return incomes, nil
+}
+
+// row is a raw income read from file.
+type row struct {
+	line     int
+	date     string
+	currency string
+	amount   string
+	category string
+	// err is set when row could not be read.
+	err error
+}
+
+func (r row) income() (service.Income, error) {
+	if r.err != nil {
+		return service.Income{}, fmt.Errorf("%w: %w", ErrInvalidRow, r.err)
+	}
+
+	date, err := dateutils.ParseDate(r.date)
+	if err != nil {
+		return service.Income{}, fmt.Errorf("%w: %w", ErrInvalidRow, err)
+	}
+
+	currency := strings.ToUpper(strings.TrimSpace(r.currency))
+	if !slices.Contains(currencies.All(), currency) {
+		return service.Income{}, fmt.Errorf("%w: unsupported currency %q", ErrInvalidRow, r.currency)
+	}
+
+	amount := strings.TrimSpace(r.amount)
+	if _, err = moneyutils.Parse(amount); err != nil {
+		return service.Income{}, fmt.Errorf("%w: %w", ErrInvalidRow, err)
+	}
+
+	return service.Income{
+		DateRequest: service.NewDateRequest(date),
+		Currency:    currency,
+		Amount:      amount,
+		Category:    strings.TrimSpace(r.category),
+	}, nil
+}
+
+func readCSV(r io.Reader) ([]row, error) {
+	cr := csv.NewReader(r)
+	cr.TrimLeadingSpace = true
+	cr.Comment = '#'
+	// Number of fields is checked per row to report all invalid rows at once.
+	cr.FieldsPerRecord = -1
+
+	// Columns order when there is no header.
+	columns := map[string]int{
+		columnDate:     0,
+		columnCurrency: 1,
+		columnAmount:   2,
+	}
+
+	var (
+		rows   []row
+		header bool
+	)
+
+	for first := true; ; first = false {
+		rec, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			var perr *csv.ParseError
+			if errors.As(err, &perr) {
+				return nil, &RowError{Line: perr.Line, Err: fmt.Errorf("%w: %w", ErrInvalidRow, perr.Err)}
+			}
+
+			return nil, fmt.Errorf("read csv: %w", err)
+		}
+
+		line, _ := cr.FieldPos(0)
+
+		if first {
+			if cols, ok := parseHeader(rec); ok {
+				columns, header = cols, true
+
+				continue
+			}
+		}
+
+		rowColumns := columns
+
+		// Category is an optional last column of files without header.
+		if !header && len(rec) == len(columns)+1 {
+			rowColumns = maps.Clone(columns)
+			rowColumns[columnCategory] = len(columns)
+		}
+
+		if len(rec) != len(rowColumns) {
+			expected := strconv.Itoa(len(columns))
+			if !header {
+				expected += " or " + strconv.Itoa(len(columns)+1)
+			}
+
+			rows = append(rows, row{
+				line: line,
+				err:  fmt.Errorf("expected %s columns, got %d", expected, len(rec)),
+			})
+
+			continue
+		}
+
+		rw := row{
+			line:     line,
+			date:     rec[rowColumns[columnDate]],
+			currency: rec[rowColumns[columnCurrency]],
+			amount:   rec[rowColumns[columnAmount]],
+		}
+
+		if i, ok := rowColumns[columnCategory]; ok {
+			rw.category = rec[i]
+		}
+
+		rows = append(rows, rw)
+	}
+
+	return rows, nil
+}
+
+// parseHeader returns index of each column when rec is a header row.
+func parseHeader(rec []string) (map[string]int, bool) {
+	columns := make(map[string]int, len(rec))
+
+	for i, name := range rec {
+		name = strings.ToLower(strings.TrimSpace(name))
+
+		switch name {
+		case columnDate, columnCurrency, columnAmount, columnCategory:
+			columns[name] = i
+		default:
+			return nil, false
+		}
+	}
+
+	// Columns must not repeat.
+	if len(columns) != len(rec) {
+		return nil, false
+	}
+
+	for _, name := range []string{columnDate, columnCurrency, columnAmount} {
+		if _, ok := columns[name]; !ok {
+			return nil, false
+		}
+	}
+
+	return columns, true
+}
+
+// jsonIncome is an income in JSON file.
+type jsonIncome struct {
+	Date     string     `json:"date"`
+	Currency string     `json:"currency"`
+	Amount   jsonAmount `json:"amount"`
+	Category string     `json:"category"`
+}
+
+// jsonAmount is an amount that may be either a number or a string in JSON.
+type jsonAmount string
+
+func (a *jsonAmount) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		*a = jsonAmount(s)
+
+		return nil
+	}
+
+	var n json.Number
+	if err := json.Unmarshal(data, &n); err != nil {
+		return fmt.Errorf("invalid amount %s", data)
+	}
+
+	*a = jsonAmount(n.String())
+
+	return nil
+}
+
+func readJSON(r io.Reader) ([]row, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("read json: %w", err)
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+
+	if err = expectDelim(dec, '['); err != nil {
+		return nil, &RowError{Line: lineAt(data, dec.InputOffset()), Err: err}
+	}
+
+	var rows []row
+
+	for dec.More() {
+		line := lineAt(data, nextValueOffset(data, dec.InputOffset()))
+
+		var inc jsonIncome
+
+		if err = dec.Decode(&inc); err != nil {
+			var serr *json.SyntaxError
+			if errors.As(err, &serr) || errors.Is(err, io.ErrUnexpectedEOF) {
+				// Syntax errors break the stream, so the rest of the file could not be read.
+				return nil, &RowError{Line: line, Err: fmt.Errorf("%w: %w", ErrInvalidRow, err)}
+			}
+
+			rows = append(rows, row{line: line, err: err})
+
+			continue
+		}
+
+		rows = append(rows, row{
+			line:     line,
+			date:     inc.Date,
+			currency: inc.Currency,
+			amount:   string(inc.Amount),
+			category: inc.Category,
+		})
+	}
+
+	if err = expectDelim(dec, ']'); err != nil {
+		return nil, &RowError{Line: lineAt(data, dec.InputOffset()), Err: err}
+	}
+
+	return rows, nil
+}
+
+func expectDelim(dec *json.Decoder, delim json.Delim) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidRow, err)
+	}
+
+	if tok != delim {
+		return fmt.Errorf("%w: expected %q, got %v", ErrInvalidRow, delim, tok)
+	}
+
+	return nil
+}
+
+// nextValueOffset skips whitespaces and separators starting from offset.
+func nextValueOffset(data []byte, offset int64) int64 {
+	for offset < int64(len(data)) {
+		switch data[offset] {
+		case ' ', '\t', '\r', '\n', ',':
+			offset++
+		default:
+			return offset
+		}
+	}
+
+	return offset
+}
+
+// lineAt returns line number of offset in data starting from 1.
+func lineAt(data []byte, offset int64) int {
+	if offset > int64(len(data)) {
+		offset = int64(len(data))
+	}
+
+	return bytes.Count(data[:offset], []byte{'\n'}) + 1
+}
@@ -0,0 +1,285 @@
+package importer
+
+import (
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/obalunenko/georgia-tax-calculator/internal/service"
+	"github.com/obalunenko/georgia-tax-calculator/pkg/nbggovge/currencies"
+)
+
+func expectedIncomes() []service.Income {
+	return []service.Income{
+		{
+			DateRequest: service.DateRequest{Year: "2023", Month: "May", Day: "08"},
+			Currency:    currencies.USD,
+			Amount:      "10000",
+		},
+		{
+			DateRequest: service.DateRequest{Year: "2023", Month: "June", Day: "08"},
+			Currency:    currencies.EUR,
+			Amount:      "200.50",
+		},
+	}
+}
+
+func incomesWithCategory(categories ...string) []service.Income {
+	incomes := expectedIncomes()
+
+	for i, c := range categories {
+		incomes[i].Category = c
+	}
+
+	return incomes
+}
+
+func TestReadFile(t *testing.T) {
+	tests := []struct {
+		name    string
+		path    string
+		want    []service.Income
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name:    "csv",
+			path:    filepath.Join("testdata", "incomes.csv"),
+			want:    expectedIncomes(),
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "json",
+			path:    filepath.Join("testdata", "incomes.json"),
+			want:    expectedIncomes(),
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "not exist",
+			path:    filepath.Join("testdata", "not-exist.csv"),
+			want:    nil,
+			wantErr: assert.Error,
+		},
+		{
+			name:    "unsupported format",
+			path:    filepath.Join("testdata", "incomes.txt"),
+			want:    nil,
+			wantErr: assert.Error,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ReadFile(tt.path)
+			if !tt.wantErr(t, err) {
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRead(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  Format
+		input   string
+		want    []service.Income
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name:    "csv without header",
+			format:  FormatCSV,
+			input:   "2023-05-08,USD,10000\n2023-06-08, eur ,200.50\n",
+			want:    expectedIncomes(),
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "csv with header in custom order and comments",
+			format:  FormatCSV,
+			input:   "# incomes\nAmount,Date,Currency\n10000,2023-05-08,USD\n200.50,2023-06-08,EUR\n",
+			want:    expectedIncomes(),
+			wantErr: assert.NoError,
+		},
+		{
+			name:   "csv with localized amounts",
+			format: FormatCSV,
+			input:  "2023-05-08,USD,\"$10,000\"\n2023-06-08,EUR,\"200,50 €\"\n",
+			want: []service.Income{
+				{
+					DateRequest: service.DateRequest{Year: "2023", Month: "May", Day: "08"},
+					Currency:    currencies.USD,
+					Amount:      "$10,000",
+				},
+				{
+					DateRequest: service.DateRequest{Year: "2023", Month: "June", Day: "08"},
+					Currency:    currencies.EUR,
+					Amount:      "200,50 €",
+				},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "csv with category column without header",
+			format:  FormatCSV,
+			input:   "2023-05-08,USD,10000,royalty\n2023-06-08,EUR,200.50\n",
+			want:    incomesWithCategory("royalty", ""),
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "csv with category column in header",
+			format:  FormatCSV,
+			input:   "category,date,currency,amount\n,2023-05-08,USD,10000\n rent ,2023-06-08,EUR,200.50\n",
+			want:    incomesWithCategory("", "rent"),
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "json with category",
+			format:  FormatJSON,
+			input:   `[{"date":"2023-05-08","currency":"USD","amount":10000,"category":"royalty"},{"date":"2023-06-08","currency":"EUR","amount":"200.50"}]`,
+			want:    incomesWithCategory("royalty", ""),
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "csv empty",
+			format:  FormatCSV,
+			input:   "date,currency,amount\n",
+			want:    nil,
+			wantErr: assert.Error,
+		},
+		{
+			name:    "json",
+			format:  FormatJSON,
+			input:   `[{"date":"2023-05-08","currency":"USD","amount":"10000"},{"date":"2023-06-08","currency":"EUR","amount":200.50}]`,
+			want:    expectedIncomes(),
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "json not an array",
+			format:  FormatJSON,
+			input:   `{"date":"2023-05-08","currency":"USD","amount":"10000"}`,
+			want:    nil,
+			wantErr: assert.Error,
+		},
+		{
+			name:    "unsupported format",
+			format:  "xml",
+			input:   "",
+			want:    nil,
+			wantErr: assert.Error,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Read(strings.NewReader(tt.input), tt.format)
+			if !tt.wantErr(t, err) {
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRead_RowErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		format    Format
+		input     string
+		wantLines []int
+	}{
+		{
+			name:   "csv",
+			format: FormatCSV,
+			input: "date,currency,amount\n" +
+				"2023-05-08,USD,10000\n" +
+				"2023-02-30,USD,10000\n" +
+				"2023-05-08,XXX,10000\n" +
+				"2023-05-08,USD,ten\n" +
+				"2023-05-08,USD\n" +
+				"2023-05-08,USD,10000,royalty,extra\n" +
+				"2023-05-08,USD,\"10,000\"\n",
+			wantLines: []int{3, 4, 5, 6, 7, 8},
+		},
+		{
+			name:   "json",
+			format: FormatJSON,
+			input: "[\n" +
+				"  {\"date\": \"2023-05-08\", \"currency\": \"USD\", \"amount\": 10000},\n" +
+				"  {\"date\": \"08.05.2023\", \"currency\": \"USD\", \"amount\": 10000},\n" +
+				"  {\"date\": \"2023-05-08\", \"currency\": \"USD\", \"amount\": true},\n" +
+				"  {\"date\": 20230508, \"currency\": \"USD\", \"amount\": 1}\n" +
+				"]\n",
+			wantLines: []int{3, 4, 5},
+		},
+		{
+			name:      "json syntax error",
+			format:    FormatJSON,
+			input:     "[\n  {\"date\": \"2023-05-08\"},\n  {\"date\": }\n]\n",
+			wantLines: []int{3},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Read(strings.NewReader(tt.input), tt.format)
+			require.ErrorIs(t, err, ErrInvalidRow)
+
+			assert.Equal(t, tt.wantLines, rowErrorLines(err))
+		})
+	}
+}
+
+func TestRead_ColumnsCount(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr string
+	}{
+		{
+			name:    "header",
+			input:   "date,currency,amount\n2023-05-08,USD,10000,royalty\n",
+			wantErr: "line 2: invalid row: expected 3 columns, got 4",
+		},
+		{
+			name:    "no header",
+			input:   "2023-05-08,USD\n",
+			wantErr: "line 1: invalid row: expected 3 or 4 columns, got 2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Read(strings.NewReader(tt.input), FormatCSV)
+			require.ErrorIs(t, err, ErrInvalidRow)
+
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
+
+func rowErrorLines(err error) []int {
+	var errs []error
+
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		errs = joined.Unwrap()
+	} else {
+		errs = []error{err}
+	}
+
+	lines := make([]int, 0, len(errs))
+
+	for _, e := range errs {
+		var rerr *RowError
+		if errors.As(e, &rerr) {
+			lines = append(lines, rerr.Line)
+		}
+	}
+
+	return lines
+}
@@ -2,29 +2,168 @@
 package models
 
 import (
+	"errors"
 	"fmt"
-	"strconv"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/obalunenko/georgia-tax-calculator/pkg/moneyutils"
+	"github.com/obalunenko/georgia-tax-calculator/pkg/nbggovge/currencies"
+)
+
+var (
+	// ErrCurrencyMismatch returned when operation is applied to money in different currencies.
+	ErrCurrencyMismatch = errors.New("currency mismatch")
+	// ErrInvalidRatios returned when money could not be allocated by passed ratios.
+	ErrInvalidRatios = errors.New("invalid ratios")
 )
 
 // Money model.
 type Money struct {
-	Amount   float64
+	Amount   decimal.Decimal
 	Currency string
 }
 
-// NewMoney constructor for Money.
-func NewMoney(amount float64, currency string) Money {
+// NewMoney constructor for Money. Amount is stored in canonical form, so equal amounts,
+// e.g. 2.5 and 2.50, are equal models.
+func NewMoney(amount decimal.Decimal, currency string) Money {
 	return Money{
-		Amount:   amount,
+		Amount:   moneyutils.Normalize(amount),
 		Currency: currency,
 	}
 }
 
+// String returns amount formatted with minor units of currency followed by currency, e.g. "10.50 USD".
 func (r Money) String() string {
-	a := strconv.FormatFloat(r.Amount, 'f', -1, 64)
 	if r.Currency == "" {
-		return a
+		return r.FormatAmount()
+	}
+
+	return fmt.Sprintf("%s %s", r.FormatAmount(), r.Currency)
+}
+
+// FormatAmount returns amount with as many decimal places as minor units of currency, e.g. "10.50" for USD and "1050" for JPY.
+// Amount of money without known currency is formatted as is.
+func (r Money) FormatAmount() string {
+	info, ok := currencies.Lookup(r.Currency)
+	if !ok {
+		return r.Amount.String()
+	}
+
+	return r.Amount.StringFixed(info.MinorUnits)
+}
+
+// Round returns money rounded half-up to minor units of currency, e.g. to tetri for GEL.
+func (r Money) Round() Money {
+	return r.RoundWith(RoundingHalfUp)
+}
+
+// Add returns sum of money. It fails with ErrCurrencyMismatch when currencies differ.
+func (r Money) Add(other Money) (Money, error) {
+	currency, err := r.currency(other)
+	if err != nil {
+		return Money{}, err
+	}
+
+	return NewMoney(moneyutils.Add(r.Amount, other.Amount), currency), nil
+}
+
+// Sub returns result of subtraction other from money. It fails with ErrCurrencyMismatch when currencies differ.
+func (r Money) Sub(other Money) (Money, error) {
+	currency, err := r.currency(other)
+	if err != nil {
+		return Money{}, err
+	}
+
+	return NewMoney(moneyutils.Sub(r.Amount, other.Amount), currency), nil
+}
+
+// Mul returns money multiplied by factor, e.g. by rate.
+func (r Money) Mul(factor decimal.Decimal) Money {
+	return NewMoney(moneyutils.Multiply(r.Amount, factor), r.Currency)
+}
+
+// Cmp compares money and returns -1, 0 or +1 when money is less than, equal to or greater than other.
+// It fails with ErrCurrencyMismatch when currencies differ.
+func (r Money) Cmp(other Money) (int, error) {
+	if _, err := r.currency(other); err != nil {
+		return 0, err
+	}
+
+	return r.Amount.Cmp(other.Amount), nil
+}
+
+// Allocate splits money into parts proportional to ratios, so that parts are in whole minor units
+// and their sum is exactly the money. Remainder is spread by minor unit starting from the first part.
+func (r Money) Allocate(ratios ...int64) ([]Money, error) {
+	var total int64
+
+	for _, ratio := range ratios {
+		if ratio < 0 {
+			return nil, fmt.Errorf("negative ratio %d: %w", ratio, ErrInvalidRatios)
+		}
+
+		total += ratio
+	}
+
+	if total == 0 {
+		return nil, fmt.Errorf("%v: %w", ratios, ErrInvalidRatios)
+	}
+
+	places := currencies.MinorUnits(r.Currency)
+	shares := make([]decimal.Decimal, len(ratios))
+	rest := r.Amount
+
+	for i, ratio := range ratios {
+		share := moneyutils.Div(moneyutils.Multiply(r.Amount, decimal.NewFromInt(ratio)), decimal.NewFromInt(total))
+
+		shares[i] = share.Truncate(places)
+		rest = moneyutils.Sub(rest, shares[i])
+	}
+
+	unit := decimal.New(1, -places)
+	if rest.IsNegative() {
+		unit = unit.Neg()
 	}
 
-	return fmt.Sprintf("%s %s", a, r.Currency)
+	for i := 0; !rest.IsZero(); i = (i + 1) % len(shares) {
+		if ratios[i] == 0 {
+			continue
+		}
+
+		step := unit
+		if rest.Abs().LessThan(unit.Abs()) {
+			step = rest
+		}
+
+		shares[i] = moneyutils.Add(shares[i], step)
+		rest = moneyutils.Sub(rest, step)
+	}
+
+	parts := make([]Money, len(shares))
+
+	for i := range shares {
+		parts[i] = NewMoney(shares[i], r.Currency)
+	}
+
+	return parts, nil
+}
+
+// currency returns currency of result of operation on money and other.
+// Zero Money has no currency and is compatible with any money, so sums could start from it.
+func (r Money) currency(other Money) (string, error) {
+	switch {
+	case r.Currency == other.Currency:
+		return r.Currency, nil
+	case r.isZeroValue():
+		return other.Currency, nil
+	case other.isZeroValue():
+		return r.Currency, nil
+	default:
+		return "", fmt.Errorf("%s and %s: %w", r.Currency, other.Currency, ErrCurrencyMismatch)
+	}
+}
+
+func (r Money) isZeroValue() bool {
+	return r.Currency == "" && r.Amount.IsZero()
 }
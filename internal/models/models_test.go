@@ -3,14 +3,16 @@
 import (
 	"testing"
 
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/obalunenko/georgia-tax-calculator/pkg/nbggovge/currencies"
 )
 
 func TestMoney_String(t *testing.T) {
 	type fields struct {
-		Amount   float64
+		Amount   string
 		Currency string
 	}
 
@@ -22,42 +24,308 @@ type fields struct {
 		{
 			name: "",
 			fields: fields{
-				Amount:   25.26789,
+				Amount:   "25.26789",
 				Currency: currencies.GEL,
 			},
-			want: "25.26789 GEL",
+			want: "25.27 GEL",
 		},
 		{
 			name: "",
 			fields: fields{
-				Amount:   25.21289,
+				Amount:   "25.21289",
 				Currency: currencies.GEL,
 			},
-			want: "25.21289 GEL",
+			want: "25.21 GEL",
 		},
 		{
 			name: "",
 			fields: fields{
-				Amount:   25.21489,
+				Amount:   "25.21489",
 				Currency: currencies.GEL,
 			},
-			want: "25.21489 GEL",
+			want: "25.21 GEL",
 		},
 		{
 			name: "",
 			fields: fields{
-				Amount:   25.21489,
+				Amount:   "25.21489",
 				Currency: "",
 			},
 			want: "25.21489",
 		},
+		{
+			name: "no minor units",
+			fields: fields{
+				Amount:   "1050",
+				Currency: currencies.JPY,
+			},
+			want: "1050 JPY",
+		},
+		{
+			name: "three minor units",
+			fields: fields{
+				Amount:   "10.5",
+				Currency: currencies.KWD,
+			},
+			want: "10.500 KWD",
+		},
+		{
+			name: "unknown currency",
+			fields: fields{
+				Amount:   "10.5",
+				Currency: "XXX",
+			},
+			want: "10.5 XXX",
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			r := NewMoney(tt.fields.Amount, tt.fields.Currency)
+			r := NewMoney(decimal.RequireFromString(tt.fields.Amount), tt.fields.Currency)
 
 			assert.Equalf(t, tt.want, r.String(), "String()")
 		})
 	}
 }
+
+func TestNewMoney(t *testing.T) {
+	tests := []struct {
+		name string
+		a    string
+		b    string
+	}{
+		{
+			name: "trailing zeros",
+			a:    "2.5",
+			b:    "2.50",
+		},
+		{
+			name: "zero",
+			a:    "0",
+			b:    "0.00",
+		},
+		{
+			name: "integer",
+			a:    "1000",
+			b:    "1000.000",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := NewMoney(decimal.RequireFromString(tt.a), currencies.GEL)
+			b := NewMoney(decimal.RequireFromString(tt.b), currencies.GEL)
+
+			assert.Equal(t, a, b)
+			assert.Equal(t, a, NewMoney(a.Amount, currencies.GEL))
+		})
+	}
+
+	assert.Equal(t, Money{}, NewMoney(decimal.Zero, ""))
+}
+
+func money(amount, currency string) Money {
+	return NewMoney(decimal.RequireFromString(amount), currency)
+}
+
+func TestMoney_Add(t *testing.T) {
+	tests := []struct {
+		name    string
+		a       Money
+		b       Money
+		want    Money
+		wantErr error
+	}{
+		{
+			name: "same currency",
+			a:    money("0.1", currencies.GEL),
+			b:    money("0.2", currencies.GEL),
+			want: money("0.3", currencies.GEL),
+		},
+		{
+			name: "zero value",
+			a:    Money{},
+			b:    money("10", currencies.USD),
+			want: money("10", currencies.USD),
+		},
+		{
+			name:    "different currencies",
+			a:       money("10", currencies.GEL),
+			b:       money("10", currencies.USD),
+			wantErr: ErrCurrencyMismatch,
+		},
+		{
+			name:    "currency not set",
+			a:       money("10", ""),
+			b:       money("10", currencies.USD),
+			wantErr: ErrCurrencyMismatch,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.a.Add(tt.b)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestMoney_Sub(t *testing.T) {
+	got, err := money("10", currencies.GEL).Sub(money("10.01", currencies.GEL))
+	require.NoError(t, err)
+	assert.Equal(t, money("-0.01", currencies.GEL), got)
+
+	_, err = money("10", currencies.GEL).Sub(money("1", currencies.EUR))
+	require.ErrorIs(t, err, ErrCurrencyMismatch)
+	assert.EqualError(t, err, "GEL and EUR: currency mismatch")
+}
+
+func TestMoney_Mul(t *testing.T) {
+	got := money("100", currencies.USD).Mul(decimal.RequireFromString("2.6500"))
+	assert.Equal(t, money("265", currencies.USD), got)
+}
+
+func TestMoney_Cmp(t *testing.T) {
+	got, err := money("2.5", currencies.GEL).Cmp(money("2.50", currencies.GEL))
+	require.NoError(t, err)
+	assert.Equal(t, 0, got)
+
+	got, err = money("2.49", currencies.GEL).Cmp(money("2.5", currencies.GEL))
+	require.NoError(t, err)
+	assert.Equal(t, -1, got)
+
+	_, err = money("2.5", currencies.GEL).Cmp(money("2.5", currencies.USD))
+	require.ErrorIs(t, err, ErrCurrencyMismatch)
+}
+
+func TestMoney_Allocate(t *testing.T) {
+	tests := []struct {
+		name    string
+		m       Money
+		ratios  []int64
+		want    []string
+		wantErr error
+	}{
+		{
+			name:   "even",
+			m:      money("100", currencies.GEL),
+			ratios: []int64{1, 1},
+			want:   []string{"50", "50"},
+		},
+		{
+			name:   "remainder",
+			m:      money("100", currencies.GEL),
+			ratios: []int64{1, 1, 1},
+			want:   []string{"33.34", "33.33", "33.33"},
+		},
+		{
+			name:   "proportional",
+			m:      money("0.05", currencies.GEL),
+			ratios: []int64{3, 7},
+			want:   []string{"0.02", "0.03"},
+		},
+		{
+			name:   "zero ratio",
+			m:      money("0.05", currencies.GEL),
+			ratios: []int64{0, 1, 1},
+			want:   []string{"0", "0.03", "0.02"},
+		},
+		{
+			name:   "negative",
+			m:      money("-0.05", currencies.GEL),
+			ratios: []int64{1, 1},
+			want:   []string{"-0.03", "-0.02"},
+		},
+		{
+			name:   "sub minor unit",
+			m:      money("0.005", currencies.GEL),
+			ratios: []int64{1, 1},
+			want:   []string{"0.005", "0"},
+		},
+		{
+			name:   "no minor units",
+			m:      money("100", currencies.JPY),
+			ratios: []int64{1, 1, 1},
+			want:   []string{"34", "33", "33"},
+		},
+		{
+			name:   "three minor units",
+			m:      money("0.01", currencies.KWD),
+			ratios: []int64{1, 1, 1},
+			want:   []string{"0.004", "0.003", "0.003"},
+		},
+		{
+			name:    "no ratios",
+			m:       money("1", currencies.GEL),
+			wantErr: ErrInvalidRatios,
+		},
+		{
+			name:    "negative ratio",
+			m:       money("1", currencies.GEL),
+			ratios:  []int64{1, -1},
+			wantErr: ErrInvalidRatios,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.m.Allocate(tt.ratios...)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, got, len(tt.want))
+
+			sum := Money{}
+
+			for i := range got {
+				assert.Equal(t, money(tt.want[i], tt.m.Currency), got[i])
+
+				sum, err = sum.Add(got[i])
+				require.NoError(t, err)
+			}
+
+			assert.Equal(t, tt.m, sum)
+		})
+	}
+}
+
+func TestMoney_Round(t *testing.T) {
+	tests := []struct {
+		m    Money
+		want Money
+	}{
+		{
+			m:    money("10.125", currencies.GEL),
+			want: money("10.13", currencies.GEL),
+		},
+		{
+			m:    money("1050.5", currencies.JPY),
+			want: money("1051", currencies.JPY),
+		},
+		{
+			m:    money("1.23456", currencies.KWD),
+			want: money("1.235", currencies.KWD),
+		},
+		{
+			m:    money("1.23456", ""),
+			want: money("1.23", ""),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.m.String(), func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.m.Round())
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/obalunenko/georgia-tax-calculator/pkg/nbggovge/currencies"
+)
+
+// ErrInvalidRoundingMode returned when rounding mode is not supported.
+var ErrInvalidRoundingMode = errors.New("invalid rounding mode")
+
+// RoundingMode is a way of rounding money to minor units of currency.
+type RoundingMode string
+
+const (
+	// RoundingHalfUp rounds half away from zero, e.g. 0.125 to 0.13.
+	RoundingHalfUp RoundingMode = "half-up"
+	// RoundingHalfEven rounds half to the nearest even minor unit, e.g. 0.125 to 0.12.
+	RoundingHalfEven RoundingMode = "half-even"
+	// RoundingTruncate drops digits below minor unit, e.g. 0.129 to 0.12.
+	RoundingTruncate RoundingMode = "truncate"
+)
+
+func (m RoundingMode) String() string {
+	return string(m)
+}
+
+// RoundingModes returns all supported rounding modes.
+func RoundingModes() []RoundingMode {
+	return []RoundingMode{RoundingHalfUp, RoundingHalfEven, RoundingTruncate}
+}
+
+// ParseRoundingMode parses RoundingMode from string, case-insensitive.
+func ParseRoundingMode(raw string) (RoundingMode, error) {
+	m := RoundingMode(strings.ToLower(strings.TrimSpace(raw)))
+
+	for _, mode := range RoundingModes() {
+		if m == mode {
+			return mode, nil
+		}
+	}
+
+	return "", fmt.Errorf("%q: %w", raw, ErrInvalidRoundingMode)
+}
+
+// RoundWith returns money rounded to minor units of currency with mode.
+// Empty mode rounds half-up.
+func (r Money) RoundWith(mode RoundingMode) Money {
+	places := currencies.MinorUnits(r.Currency)
+
+	switch mode {
+	case RoundingHalfEven:
+		return NewMoney(r.Amount.RoundBank(places), r.Currency)
+	case RoundingTruncate:
+		return NewMoney(r.Amount.Truncate(places), r.Currency)
+	default:
+		return NewMoney(r.Amount.Round(places), r.Currency)
+	}
+}
+
+// StringWith returns money rounded with mode formatted as String does, e.g. "10.12 USD" for truncated 10.129 USD.
+func (r Money) StringWith(mode RoundingMode) string {
+	return r.RoundWith(mode).String()
+}
+
+// FormatAmountWith returns amount rounded with mode formatted as FormatAmount does.
+func (r Money) FormatAmountWith(mode RoundingMode) string {
+	return r.RoundWith(mode).FormatAmount()
+}
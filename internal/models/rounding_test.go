@@ -0,0 +1,90 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/obalunenko/georgia-tax-calculator/pkg/nbggovge/currencies"
+)
+
+func TestParseRoundingMode(t *testing.T) {
+	got, err := ParseRoundingMode(" Half-Even ")
+	require.NoError(t, err)
+	assert.Equal(t, RoundingHalfEven, got)
+
+	_, err = ParseRoundingMode("ceil")
+	require.ErrorIs(t, err, ErrInvalidRoundingMode)
+}
+
+func TestMoney_RoundWith(t *testing.T) {
+	tests := []struct {
+		name string
+		m    Money
+		mode RoundingMode
+		want Money
+	}{
+		{
+			name: "half-up",
+			m:    money("0.125", currencies.GEL),
+			mode: RoundingHalfUp,
+			want: money("0.13", currencies.GEL),
+		},
+		{
+			name: "half-up negative",
+			m:    money("-0.125", currencies.GEL),
+			mode: RoundingHalfUp,
+			want: money("-0.13", currencies.GEL),
+		},
+		{
+			name: "half-even down",
+			m:    money("0.125", currencies.GEL),
+			mode: RoundingHalfEven,
+			want: money("0.12", currencies.GEL),
+		},
+		{
+			name: "half-even up",
+			m:    money("0.135", currencies.GEL),
+			mode: RoundingHalfEven,
+			want: money("0.14", currencies.GEL),
+		},
+		{
+			name: "truncate",
+			m:    money("0.129", currencies.GEL),
+			mode: RoundingTruncate,
+			want: money("0.12", currencies.GEL),
+		},
+		{
+			name: "truncate negative",
+			m:    money("-0.129", currencies.GEL),
+			mode: RoundingTruncate,
+			want: money("-0.12", currencies.GEL),
+		},
+		{
+			name: "no minor units",
+			m:    money("2.5", currencies.JPY),
+			mode: RoundingHalfEven,
+			want: money("2", currencies.JPY),
+		},
+		{
+			name: "empty mode",
+			m:    money("0.125", currencies.GEL),
+			want: money("0.13", currencies.GEL),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.m.RoundWith(tt.mode))
+		})
+	}
+}
+
+func TestMoney_StringWith(t *testing.T) {
+	m := money("13.029", currencies.GEL)
+
+	assert.Equal(t, "13.03 GEL", m.StringWith(RoundingHalfUp))
+	assert.Equal(t, "13.02 GEL", m.StringWith(RoundingTruncate))
+	assert.Equal(t, "13.02", m.FormatAmountWith(RoundingTruncate))
+}
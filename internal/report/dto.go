@@ -0,0 +1,585 @@
+package report
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/obalunenko/georgia-tax-calculator/internal/models"
+	"github.com/obalunenko/georgia-tax-calculator/internal/service"
+	"github.com/obalunenko/georgia-tax-calculator/internal/taxes"
+)
+
+// Field names of models below are the schema of JSON and YAML outputs.
+// Downstream tools depend on them, so existing fields must not be renamed or removed.
+
+const (
+	dateLayout   = time.DateOnly
+	periodLayout = "2006-01"
+)
+
+// Money model. Amount is an exact decimal number formatted as string to keep precision.
+type Money struct {
+	Amount   string `json:"amount" yaml:"amount"`
+	Currency string `json:"currency" yaml:"currency"`
+
+	// formatted is an amount with minor units of currency, e.g. "10.50", shown to people.
+	formatted string
+}
+
+// String returns formatted amount followed by currency, e.g. "10.50 USD".
+func (m Money) String() string {
+	if m.Currency == "" {
+		return m.formatted
+	}
+
+	return m.formatted + " " + m.Currency
+}
+
+// amount returns formatted amount for people and exact amount for programs.
+func (m Money) amount(human bool) string {
+	if human {
+		return m.formatted
+	}
+
+	return m.Amount
+}
+
+func newMoney(m models.Money) Money {
+	return Money{
+		Amount:    m.Amount.String(),
+		Currency:  m.Currency,
+		formatted: m.FormatAmount(),
+	}
+}
+
+// newTax creates Money of tax shown rounded with mode.
+func newTax(m models.Money, mode models.RoundingMode) Money {
+	tax := newMoney(m)
+	tax.formatted = m.FormatAmountWith(mode)
+
+	return tax
+}
+
+// TaxRate model. Rate is a decimal fraction, e.g. "0.01" for 1 %.
+type TaxRate struct {
+	Type string `json:"type" yaml:"type"`
+	Rate string `json:"rate" yaml:"rate"`
+}
+
+func newTaxRate(tr taxes.TaxRate) TaxRate {
+	return TaxRate{
+		Type: tr.Type.String(),
+		Rate: formatFloat(tr.Rate),
+	}
+}
+
+// newAppliedRates returns tax rate and all rates applied to incomes.
+// Rate of the tax rate is set only when all incomes were taxed at the same rate.
+func newAppliedRates(a service.AppliedRates) (TaxRate, []TaxRate) {
+	rates := make([]TaxRate, 0, len(a.Rates))
+
+	for _, r := range a.Rates {
+		rates = append(rates, newTaxRate(r))
+	}
+
+	if len(rates) == 1 {
+		return rates[0], rates
+	}
+
+	return TaxRate{Type: a.Type.String()}, rates
+}
+
+// formatRates returns rates separated by comma.
+func formatRates(rates []TaxRate) string {
+	values := make([]string, 0, len(rates))
+
+	for _, r := range rates {
+		values = append(values, r.Rate)
+	}
+
+	return strings.Join(values, ", ")
+}
+
+// Rounding model. Policy and Mode are values of --rounding and --rounding-mode flags.
+type Rounding struct {
+	Policy string `json:"policy" yaml:"policy"`
+	Mode   string `json:"mode" yaml:"mode"`
+}
+
+func newRounding(r taxes.Rounding) Rounding {
+	return Rounding{
+		Policy: r.Policy.String(),
+		Mode:   r.Mode.String(),
+	}
+}
+
+func (r Rounding) String() string {
+	if r.Policy == "" && r.Mode == "" {
+		return ""
+	}
+
+	return r.Policy + ", " + r.Mode
+}
+
+// TaxPart model.
+type TaxPart struct {
+	Base          Money  `json:"base" yaml:"base"`
+	Rate          string `json:"rate" yaml:"rate"`
+	Tax           Money  `json:"tax" yaml:"tax"`
+	OverThreshold bool   `json:"over_threshold" yaml:"over_threshold"`
+}
+
+func newTaxParts(parts []taxes.Part, mode models.RoundingMode) []TaxPart {
+	resp := make([]TaxPart, 0, len(parts))
+
+	for _, p := range parts {
+		resp = append(resp, TaxPart{
+			Base:          newMoney(p.Base),
+			Rate:          formatFloat(p.Rate),
+			Tax:           newTax(p.Tax, mode),
+			OverThreshold: p.OverThreshold,
+		})
+	}
+
+	return resp
+}
+
+// Pension model.
+type Pension struct {
+	Employee Money `json:"employee" yaml:"employee"`
+	Employer Money `json:"employer" yaml:"employer"`
+	State    Money `json:"state" yaml:"state"`
+	Total    Money `json:"total" yaml:"total"`
+}
+
+func newPension(p taxes.Pension) Pension {
+	return Pension{
+		Employee: newMoney(p.Employee),
+		Employer: newMoney(p.Employer),
+		State:    newMoney(p.State),
+		Total:    newMoney(p.Total()),
+	}
+}
+
+// Conversion model.
+type Conversion struct {
+	Date      string `json:"date" yaml:"date"`
+	Amount    Money  `json:"amount" yaml:"amount"`
+	Converted Money  `json:"converted" yaml:"converted"`
+	Rate      string `json:"rate" yaml:"rate"`
+	// RateDate is a date since the rate is valid, it could be earlier than Date.
+	RateDate string `json:"rate_date" yaml:"rate_date"`
+
+	txt string
+}
+
+// NewConversion creates Report of currency conversion.
+func NewConversion(resp service.ConvertResponse) Conversion {
+	return Conversion{
+		Date:      resp.Date.Format(dateLayout),
+		Amount:    newMoney(resp.Amount),
+		Converted: newMoney(resp.Converted),
+		Rate:      resp.Rate.Amount.String(),
+		RateDate:  formatDate(resp.RateDate),
+		txt:       resp.String(),
+	}
+}
+
+func (c Conversion) text() string {
+	return c.txt
+}
+
+func (c Conversion) title() string {
+	return "Currency conversion"
+}
+
+func (c Conversion) summary() [][2]string {
+	return [][2]string{
+		{"Date", c.Date},
+		{"Amount", c.Amount.String()},
+		{"Converted", c.Converted.String()},
+		{"Rate", c.Rate},
+		{"Rate Date", c.RateDate},
+	}
+}
+
+func (c Conversion) table(human bool) [][]string {
+	return [][]string{
+		{"date", "amount", "currency", "converted", "converted_currency", "rate", "rate_date"},
+		{
+			c.Date, c.Amount.amount(human), c.Amount.Currency, c.Converted.amount(human), c.Converted.Currency,
+			c.Rate, c.RateDate,
+		},
+	}
+}
+
+// CalculationIncome model.
+type CalculationIncome struct {
+	Date       string  `json:"date" yaml:"date"`
+	Amount     Money   `json:"amount" yaml:"amount"`
+	Converted  Money   `json:"converted" yaml:"converted"`
+	Rate       string  `json:"rate" yaml:"rate"`
+	RateDate   string  `json:"rate_date" yaml:"rate_date"`
+	TaxRate    TaxRate `json:"tax_rate" yaml:"tax_rate"`
+	Tax        Money   `json:"tax" yaml:"tax"`
+	YearToDate Money   `json:"year_to_date" yaml:"year_to_date"`
+	Pension    Pension `json:"pension" yaml:"pension"`
+}
+
+// ThresholdCrossing model.
+type ThresholdCrossing struct {
+	// Number of income starting from 1.
+	Number int       `json:"number" yaml:"number"`
+	Date   string    `json:"date" yaml:"date"`
+	Parts  []TaxPart `json:"parts" yaml:"parts"`
+}
+
+// Calculation model.
+type Calculation struct {
+	// TaxRate has empty Rate when incomes were taxed at different rates, see TaxRates.
+	TaxRate            TaxRate             `json:"tax_rate" yaml:"tax_rate"`
+	TaxRates           []TaxRate           `json:"tax_rates" yaml:"tax_rates"`
+	YearIncome         Money               `json:"year_income" yaml:"year_income"`
+	Incomes            []CalculationIncome `json:"incomes" yaml:"incomes"`
+	TotalIncome        Money               `json:"total_income" yaml:"total_income"`
+	TaxParts           []TaxPart           `json:"tax_parts" yaml:"tax_parts"`
+	ThresholdCrossings []ThresholdCrossing `json:"threshold_crossings" yaml:"threshold_crossings"`
+	Pension            Pension             `json:"pension" yaml:"pension"`
+	Tax                Money               `json:"tax" yaml:"tax"`
+	Rounding           Rounding            `json:"rounding" yaml:"rounding"`
+
+	txt string
+}
+
+// NewCalculation creates Report of taxes calculation.
+func NewCalculation(resp service.CalculateResponse) Calculation {
+	incomes := make([]CalculationIncome, 0, len(resp.Incomes))
+
+	for _, inc := range resp.Incomes {
+		incomes = append(incomes, CalculationIncome{
+			Date:       inc.Date.Format(dateLayout),
+			Amount:     newMoney(inc.Amount),
+			Converted:  newMoney(inc.Converted),
+			Rate:       inc.Rate.Amount.String(),
+			RateDate:   formatDate(inc.RateDate),
+			TaxRate:    newTaxRate(inc.TaxRate),
+			Tax:        newTax(inc.Tax, resp.Rounding.Mode),
+			YearToDate: newMoney(inc.YearToDate),
+			Pension:    newPension(inc.Pension),
+		})
+	}
+
+	crossings := make([]ThresholdCrossing, 0, len(resp.ThresholdCrossings))
+
+	for _, cr := range resp.ThresholdCrossings {
+		crossings = append(crossings, ThresholdCrossing{
+			Number: cr.Number,
+			Date:   cr.Date.Format(dateLayout),
+			Parts:  newTaxParts(cr.Parts, resp.Rounding.Mode),
+		})
+	}
+
+	taxRate, taxRates := newAppliedRates(resp.TaxRates)
+
+	return Calculation{
+		TaxRate:            taxRate,
+		TaxRates:           taxRates,
+		YearIncome:         newMoney(resp.YearIncome),
+		Incomes:            incomes,
+		TotalIncome:        newMoney(resp.TotalIncomeConverted),
+		TaxParts:           newTaxParts(resp.TaxParts, resp.Rounding.Mode),
+		ThresholdCrossings: crossings,
+		Pension:            newPension(resp.Pension),
+		Tax:                newMoney(resp.Tax),
+		Rounding:           newRounding(resp.Rounding),
+		txt:                resp.String(),
+	}
+}
+
+func (c Calculation) text() string {
+	return c.txt
+}
+
+func (c Calculation) title() string {
+	return "Taxes calculation"
+}
+
+func (c Calculation) summary() [][2]string {
+	return [][2]string{
+		{"Tax Type", c.TaxRate.Type},
+		{"Tax Rate", formatRates(c.TaxRates)},
+		{"Year Income", c.YearIncome.String()},
+		{"Total Income", c.TotalIncome.String()},
+		{"Pension", c.Pension.Total.String()},
+		{"Tax", c.Tax.String()},
+		{"Rounding", c.Rounding.String()},
+	}
+}
+
+func (c Calculation) table(human bool) [][]string {
+	rows := make([][]string, 0, len(c.Incomes)+1)
+
+	rows = append(rows, []string{
+		"date", "amount", "currency", "converted", "converted_currency", "rate",
+		"tax_rate", "tax", "year_to_date", "pension", "rate_date",
+	})
+
+	for _, inc := range c.Incomes {
+		rows = append(rows, []string{
+			inc.Date, inc.Amount.amount(human), inc.Amount.Currency, inc.Converted.amount(human), inc.Converted.Currency,
+			inc.Rate, inc.TaxRate.Rate, inc.Tax.amount(human), inc.YearToDate.amount(human),
+			inc.Pension.Total.amount(human), inc.RateDate,
+		})
+	}
+
+	return rows
+}
+
+// Declaration model.
+type Declaration struct {
+	Period     string `json:"period" yaml:"period"`
+	Income     Money  `json:"income" yaml:"income"`
+	Tax        Money  `json:"tax" yaml:"tax"`
+	YearIncome Money  `json:"year_income" yaml:"year_income"`
+	Deadline   string `json:"deadline" yaml:"deadline"`
+}
+
+// Declarations model.
+type Declarations struct {
+	// TaxRate has empty Rate when incomes were taxed at different rates, see TaxRates.
+	TaxRate      TaxRate       `json:"tax_rate" yaml:"tax_rate"`
+	TaxRates     []TaxRate     `json:"tax_rates" yaml:"tax_rates"`
+	Declarations []Declaration `json:"declarations" yaml:"declarations"`
+	Tax          Money         `json:"tax" yaml:"tax"`
+	Rounding     Rounding      `json:"rounding" yaml:"rounding"`
+
+	txt string
+}
+
+// NewDeclarations creates Report of monthly declarations.
+func NewDeclarations(resp service.DeclarationsResponse) Declarations {
+	decls := make([]Declaration, 0, len(resp.Declarations))
+
+	for _, d := range resp.Declarations {
+		decls = append(decls, Declaration{
+			Period:     d.Period.Format(periodLayout),
+			Income:     newMoney(d.Income),
+			Tax:        newMoney(d.Tax),
+			YearIncome: newMoney(d.YearIncome),
+			Deadline:   d.Deadline.Format(dateLayout),
+		})
+	}
+
+	taxRate, taxRates := newAppliedRates(resp.TaxRates)
+
+	return Declarations{
+		TaxRate:      taxRate,
+		TaxRates:     taxRates,
+		Declarations: decls,
+		Tax:          newMoney(resp.Tax),
+		Rounding:     newRounding(resp.Rounding),
+		txt:          resp.String(),
+	}
+}
+
+func (d Declarations) text() string {
+	return d.txt
+}
+
+func (d Declarations) title() string {
+	return "Monthly declarations"
+}
+
+func (d Declarations) summary() [][2]string {
+	return [][2]string{
+		{"Tax Type", d.TaxRate.Type},
+		{"Tax Rate", formatRates(d.TaxRates)},
+		{"Tax", d.Tax.String()},
+		{"Rounding", d.Rounding.String()},
+	}
+}
+
+func (d Declarations) table(human bool) [][]string {
+	rows := make([][]string, 0, len(d.Declarations)+1)
+
+	rows = append(rows, []string{"period", "income", "tax", "year_income", "currency", "deadline"})
+
+	for _, decl := range d.Declarations {
+		rows = append(rows, []string{
+			decl.Period, decl.Income.amount(human), decl.Tax.amount(human), decl.YearIncome.amount(human),
+			decl.Income.Currency, decl.Deadline,
+		})
+	}
+
+	return rows
+}
+
+// formatDate formats date, zero date is formatted as empty string.
+func formatDate(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.Format(dateLayout)
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+// Rate model.
+type Rate struct {
+	Code      string `json:"code" yaml:"code"`
+	Name      string `json:"name" yaml:"name"`
+	Quantity  int64  `json:"quantity" yaml:"quantity"`
+	Rate      string `json:"rate" yaml:"rate"`
+	Diff      string `json:"diff" yaml:"diff"`
+	ValidFrom string `json:"valid_from" yaml:"valid_from"`
+}
+
+// Rates model.
+type Rates struct {
+	Date  string `json:"date" yaml:"date"`
+	Rates []Rate `json:"rates" yaml:"rates"`
+
+	txt string
+}
+
+// NewRates creates Report of official rates.
+func NewRates(resp service.RatesResponse) Rates {
+	rates := make([]Rate, 0, len(resp.Rates))
+
+	for _, r := range resp.Rates {
+		rates = append(rates, Rate{
+			Code:      r.Code,
+			Name:      r.Name,
+			Quantity:  r.Quantity,
+			Rate:      formatFloat(r.Rate),
+			Diff:      formatFloat(r.Diff),
+			ValidFrom: formatDate(r.ValidFrom),
+		})
+	}
+
+	return Rates{
+		Date:  resp.Date.Format(dateLayout),
+		Rates: rates,
+		txt:   resp.String(),
+	}
+}
+
+func (r Rates) text() string {
+	return r.txt
+}
+
+func (r Rates) title() string {
+	return "Official rates"
+}
+
+func (r Rates) summary() [][2]string {
+	return [][2]string{
+		{"Date", r.Date},
+		{"Currencies", strconv.Itoa(len(r.Rates))},
+	}
+}
+
+func (r Rates) table(bool) [][]string {
+	rows := make([][]string, 0, len(r.Rates)+1)
+
+	rows = append(rows, []string{"code", "name", "quantity", "rate", "diff", "valid_from"})
+
+	for _, rate := range r.Rates {
+		rows = append(rows, []string{
+			rate.Code, rate.Name, strconv.FormatInt(rate.Quantity, 10), rate.Rate, rate.Diff, rate.ValidFrom,
+		})
+	}
+
+	return rows
+}
+
+// RatePoint model.
+type RatePoint struct {
+	Date     string `json:"date" yaml:"date"`
+	Quantity int64  `json:"quantity" yaml:"quantity"`
+	Rate     string `json:"rate" yaml:"rate"`
+}
+
+// CurrencyHistory model. Statistics are empty when no rates are published in range.
+type CurrencyHistory struct {
+	Code    string      `json:"code" yaml:"code"`
+	Min     string      `json:"min" yaml:"min"`
+	Max     string      `json:"max" yaml:"max"`
+	Average string      `json:"average" yaml:"average"`
+	Points  []RatePoint `json:"points" yaml:"points"`
+}
+
+// RatesHistory model.
+type RatesHistory struct {
+	From       string            `json:"from" yaml:"from"`
+	To         string            `json:"to" yaml:"to"`
+	Currencies []CurrencyHistory `json:"currencies" yaml:"currencies"`
+
+	txt string
+}
+
+// NewRatesHistory creates Report of official rates history.
+func NewRatesHistory(resp service.RatesHistoryResponse) RatesHistory {
+	hist := make([]CurrencyHistory, 0, len(resp.Currencies))
+
+	for _, h := range resp.Currencies {
+		ch := CurrencyHistory{
+			Code:   h.Code,
+			Points: make([]RatePoint, 0, len(h.Points)),
+		}
+
+		if len(h.Points) != 0 {
+			ch.Min = formatFloat(h.Min)
+			ch.Max = formatFloat(h.Max)
+			ch.Average = formatFloat(h.Average)
+		}
+
+		for _, p := range h.Points {
+			ch.Points = append(ch.Points, RatePoint{
+				Date:     p.Date.Format(dateLayout),
+				Quantity: p.Quantity,
+				Rate:     formatFloat(p.Rate),
+			})
+		}
+
+		hist = append(hist, ch)
+	}
+
+	return RatesHistory{
+		From:       resp.From.Format(dateLayout),
+		To:         resp.To.Format(dateLayout),
+		Currencies: hist,
+		txt:        resp.String(),
+	}
+}
+
+func (r RatesHistory) text() string {
+	return r.txt
+}
+
+func (r RatesHistory) title() string {
+	return "Official rates history"
+}
+
+func (r RatesHistory) summary() [][2]string {
+	return [][2]string{
+		{"From", r.From},
+		{"To", r.To},
+	}
+}
+
+func (r RatesHistory) table(bool) [][]string {
+	rows := make([][]string, 0, len(r.Currencies)+1)
+
+	rows = append(rows, []string{"code", "min", "max", "average", "points"})
+
+	for _, h := range r.Currencies {
+		rows = append(rows, []string{h.Code, h.Min, h.Max, h.Average, strconv.Itoa(len(h.Points))})
+	}
+
+	return rows
+}
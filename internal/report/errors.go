@@ -0,0 +1,60 @@
+package report
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/obalunenko/georgia-tax-calculator/internal/converter"
+	"github.com/obalunenko/georgia-tax-calculator/pkg/nbggovge"
+)
+
+// ErrorMessage returns a message describing err for users.
+// Errors of rates API and conversion are explained, other errors are returned as is.
+func ErrorMessage(err error) string {
+	var (
+		nerr *converter.NoRatesError
+		uerr *nbggovge.UnknownCurrencyError
+		serr *nbggovge.StatusError
+		derr *nbggovge.DecodeError
+	)
+
+	switch {
+	case errors.As(err, &nerr):
+		if nerr.FallbackDays == 0 {
+			return fmt.Sprintf("National Bank of Georgia has not published exchange rates for %s.",
+				nerr.Date.Format(dateLayout))
+		}
+
+		return fmt.Sprintf("National Bank of Georgia has not published exchange rates for %s or %d days before.",
+			nerr.Date.Format(dateLayout), nerr.FallbackDays)
+	case errors.As(err, &uerr):
+		if uerr.Date.IsZero() {
+			return fmt.Sprintf("National Bank of Georgia does not publish exchange rate of %s.", uerr.Code)
+		}
+
+		return fmt.Sprintf("National Bank of Georgia does not publish exchange rate of %s for %s.",
+			uerr.Code, uerr.Date.Format(dateLayout))
+	case errors.As(err, &serr):
+		return statusErrorMessage(serr)
+	case errors.As(err, &derr):
+		return "National Bank of Georgia returned unexpected exchange rates data. Please try again later."
+	default:
+		return err.Error()
+	}
+}
+
+func statusErrorMessage(err *nbggovge.StatusError) string {
+	switch {
+	case err.StatusCode == http.StatusTooManyRequests && err.RetryAfter > 0:
+		return fmt.Sprintf("Too many requests to National Bank of Georgia. Please try again in %s.",
+			err.RetryAfter.Round(time.Second))
+	case err.StatusCode == http.StatusTooManyRequests:
+		return "Too many requests to National Bank of Georgia. Please try again later."
+	case err.StatusCode >= http.StatusInternalServerError:
+		return "National Bank of Georgia exchange rates service is unavailable. Please try again later."
+	default:
+		return fmt.Sprintf("National Bank of Georgia rejected exchange rates request (%d).", err.StatusCode)
+	}
+}
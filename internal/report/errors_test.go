@@ -0,0 +1,81 @@
+package report
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/obalunenko/georgia-tax-calculator/internal/converter"
+	"github.com/obalunenko/georgia-tax-calculator/pkg/nbggovge"
+)
+
+func TestErrorMessage(t *testing.T) {
+	date := time.Date(2023, time.September, 10, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{
+			name: "no rates",
+			err:  fmt.Errorf("convert: %w", &converter.NoRatesError{Date: date}),
+			want: "National Bank of Georgia has not published exchange rates for 2023-09-10.",
+		},
+		{
+			name: "no rates with fallback",
+			err:  &converter.NoRatesError{Date: date, FallbackDays: 14},
+			want: "National Bank of Georgia has not published exchange rates for 2023-09-10 or 14 days before.",
+		},
+		{
+			name: "unknown currency",
+			err:  &nbggovge.UnknownCurrencyError{Code: "XXX", Date: date},
+			want: "National Bank of Georgia does not publish exchange rate of XXX for 2023-09-10.",
+		},
+		{
+			name: "unknown currency without date",
+			err:  &nbggovge.UnknownCurrencyError{Code: "XXX"},
+			want: "National Bank of Georgia does not publish exchange rate of XXX.",
+		},
+		{
+			name: "rate limited",
+			err:  &nbggovge.StatusError{StatusCode: http.StatusTooManyRequests, RetryAfter: time.Minute * 2},
+			want: "Too many requests to National Bank of Georgia. Please try again in 2m0s.",
+		},
+		{
+			name: "rate limited without retry after",
+			err:  &nbggovge.StatusError{StatusCode: http.StatusTooManyRequests},
+			want: "Too many requests to National Bank of Georgia. Please try again later.",
+		},
+		{
+			name: "unavailable",
+			err:  &nbggovge.StatusError{StatusCode: http.StatusServiceUnavailable},
+			want: "National Bank of Georgia exchange rates service is unavailable. Please try again later.",
+		},
+		{
+			name: "rejected",
+			err:  &nbggovge.StatusError{StatusCode: http.StatusBadRequest},
+			want: "National Bank of Georgia rejected exchange rates request (400).",
+		},
+		{
+			name: "decode",
+			err:  &nbggovge.DecodeError{Err: errors.New("invalid character")},
+			want: "National Bank of Georgia returned unexpected exchange rates data. Please try again later.",
+		},
+		{
+			name: "other",
+			err:  errors.New("amount must be positive"),
+			want: "amount must be positive",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ErrorMessage(tt.err))
+		})
+	}
+}
@@ -0,0 +1,143 @@
+// Package report renders results of commands in different output formats.
+package report
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ErrUnsupportedFormat returned when output format is not supported.
+var ErrUnsupportedFormat = errors.New("unsupported output format")
+
+// Format of output.
+type Format string
+
+const (
+	// FormatText is a human-readable text.
+	FormatText Format = "text"
+	// FormatJSON is a JSON document.
+	FormatJSON Format = "json"
+	// FormatYAML is a YAML document.
+	FormatYAML Format = "yaml"
+	// FormatCSV is a CSV table with header row.
+	FormatCSV Format = "csv"
+	// FormatMarkdown is a Markdown document with tables.
+	FormatMarkdown Format = "markdown"
+)
+
+// Formats returns all supported formats.
+func Formats() []Format {
+	return []Format{FormatText, FormatJSON, FormatYAML, FormatCSV, FormatMarkdown}
+}
+
+// ParseFormat parses Format from string.
+func ParseFormat(raw string) (Format, error) {
+	f := Format(strings.ToLower(strings.TrimSpace(raw)))
+
+	for _, format := range Formats() {
+		if f == format {
+			return f, nil
+		}
+	}
+
+	return "", fmt.Errorf("%s: %w", raw, ErrUnsupportedFormat)
+}
+
+// Report is a result of a command that could be written in any Format.
+type Report interface {
+	// text returns human-readable representation.
+	text() string
+	// title returns heading of Markdown document.
+	title() string
+	// summary returns totals as field - value pairs.
+	summary() [][2]string
+	// table returns header and rows of report items. Amounts are formatted with minor units of currency
+	// when table is for people, otherwise they are exact.
+	table(human bool) [][]string
+}
+
+// Write writes Report in format to w.
+func Write(w io.Writer, format Format, r Report) error {
+	switch format {
+	case FormatText:
+		_, err := fmt.Fprintln(w, r.text())
+
+		return err
+	case FormatJSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+
+		return enc.Encode(r)
+	case FormatYAML:
+		enc := yaml.NewEncoder(w)
+
+		const indent = 2
+
+		enc.SetIndent(indent)
+
+		if err := enc.Encode(r); err != nil {
+			return err
+		}
+
+		return enc.Close()
+	case FormatCSV:
+		cw := csv.NewWriter(w)
+
+		if err := cw.WriteAll(r.table(false)); err != nil {
+			return fmt.Errorf("write csv: %w", err)
+		}
+
+		return nil
+	case FormatMarkdown:
+		_, err := io.WriteString(w, markdown(r))
+
+		return err
+	default:
+		return fmt.Errorf("%s: %w", format, ErrUnsupportedFormat)
+	}
+}
+
+func markdown(r Report) string {
+	var b strings.Builder
+
+	b.WriteString(fmt.Sprintf("# %s\n\n", r.title()))
+
+	summary := make([][]string, 0, len(r.summary())+1)
+	summary = append(summary, []string{"Field", "Value"})
+
+	for _, kv := range r.summary() {
+		summary = append(summary, []string{kv[0], kv[1]})
+	}
+
+	writeMarkdownTable(&b, summary)
+
+	if rows := r.table(true); len(rows) > 1 {
+		b.WriteString("\n")
+
+		writeMarkdownTable(&b, rows)
+	}
+
+	return b.String()
+}
+
+func writeMarkdownTable(b *strings.Builder, rows [][]string) {
+	for i, row := range rows {
+		cells := make([]string, len(row))
+
+		for j := range row {
+			cells[j] = strings.ReplaceAll(row[j], "|", `\|`)
+		}
+
+		b.WriteString("| " + strings.Join(cells, " | ") + " |\n")
+
+		if i == 0 {
+			b.WriteString(strings.Repeat("| --- ", len(row)) + "|\n")
+		}
+	}
+}
@@ -0,0 +1,405 @@
+package report
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/obalunenko/georgia-tax-calculator/internal/models"
+	"github.com/obalunenko/georgia-tax-calculator/internal/service"
+	"github.com/obalunenko/georgia-tax-calculator/internal/taxes"
+	"github.com/obalunenko/georgia-tax-calculator/pkg/nbggovge/currencies"
+)
+
+func money(amount, currency string) models.Money {
+	return models.NewMoney(decimal.RequireFromString(amount), currency)
+}
+
+func TestParseFormat(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     string
+		want    Format
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name:    "json",
+			raw:     "json",
+			want:    FormatJSON,
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "case and spaces",
+			raw:     " Markdown ",
+			want:    FormatMarkdown,
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "unsupported",
+			raw:     "xml",
+			want:    "",
+			wantErr: assert.Error,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseFormat(tt.raw)
+			if !tt.wantErr(t, err) {
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func conversion() Conversion {
+	return NewConversion(service.ConvertResponse{
+		Date:      time.Date(2023, time.June, 8, 0, 0, 0, 0, time.UTC),
+		Amount:    money("100", currencies.USD),
+		Converted: money("260.5", currencies.GEL),
+		Rate:      money("2.605", ""),
+		RateDate:  time.Date(2023, time.June, 8, 0, 0, 0, 0, time.UTC),
+	})
+}
+
+func TestWrite_Conversion(t *testing.T) {
+	tests := []struct {
+		format Format
+		want   string
+	}{
+		{
+			format: FormatText,
+			want: "Date: 2023-06-08\n" +
+				"Amount: 100.00 USD\n" +
+				"Converted: 260.50 GEL\n" +
+				"Rate: 2.605\n" +
+				"Rate Date: 2023-06-08\n",
+		},
+		{
+			format: FormatJSON,
+			want: `{
+  "date": "2023-06-08",
+  "amount": {
+    "amount": "100",
+    "currency": "USD"
+  },
+  "converted": {
+    "amount": "260.5",
+    "currency": "GEL"
+  },
+  "rate": "2.605",
+  "rate_date": "2023-06-08"
+}
+`,
+		},
+		{
+			format: FormatYAML,
+			want: `date: "2023-06-08"
+amount:
+  amount: "100"
+  currency: USD
+converted:
+  amount: "260.5"
+  currency: GEL
+rate: "2.605"
+rate_date: "2023-06-08"
+`,
+		},
+		{
+			format: FormatCSV,
+			want: "date,amount,currency,converted,converted_currency,rate,rate_date\n" +
+				"2023-06-08,100,USD,260.5,GEL,2.605,2023-06-08\n",
+		},
+		{
+			format: FormatMarkdown,
+			want: "# Currency conversion\n\n" +
+				"| Field | Value |\n" +
+				"| --- | --- |\n" +
+				"| Date | 2023-06-08 |\n" +
+				"| Amount | 100.00 USD |\n" +
+				"| Converted | 260.50 GEL |\n" +
+				"| Rate | 2.605 |\n" +
+				"| Rate Date | 2023-06-08 |\n" +
+				"\n" +
+				"| date | amount | currency | converted | converted_currency | rate | rate_date |\n" +
+				"| --- | --- | --- | --- | --- | --- | --- |\n" +
+				"| 2023-06-08 | 100.00 | USD | 260.50 | GEL | 2.605 | 2023-06-08 |\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.format), func(t *testing.T) {
+			var buf bytes.Buffer
+
+			require.NoError(t, Write(&buf, tt.format, conversion()))
+
+			assert.Equal(t, tt.want, buf.String())
+		})
+	}
+
+	require.ErrorIs(t, Write(&bytes.Buffer{}, "xml", conversion()), ErrUnsupportedFormat)
+}
+
+func TestWrite_ExactAmounts(t *testing.T) {
+	conv := NewConversion(service.ConvertResponse{
+		Date:      time.Date(2023, time.June, 8, 0, 0, 0, 0, time.UTC),
+		Amount:    money("100.125", currencies.USD),
+		Converted: money("260.825625", currencies.GEL),
+		Rate:      money("2.605", ""),
+		RateDate:  time.Date(2023, time.June, 8, 0, 0, 0, 0, time.UTC),
+	})
+
+	var buf bytes.Buffer
+
+	// Amounts are exact for programs.
+	require.NoError(t, Write(&buf, FormatCSV, conv))
+	assert.Equal(t, "date,amount,currency,converted,converted_currency,rate,rate_date\n"+
+		"2023-06-08,100.125,USD,260.825625,GEL,2.605,2023-06-08\n", buf.String())
+
+	buf.Reset()
+
+	// Amounts are formatted with minor units of currency for people.
+	require.NoError(t, Write(&buf, FormatMarkdown, conv))
+	assert.Contains(t, buf.String(), "| Amount | 100.13 USD |\n")
+	assert.Contains(t, buf.String(), "| 2023-06-08 | 100.13 | USD | 260.83 | GEL | 2.605 | 2023-06-08 |\n")
+}
+
+func TestWrite_Calculation(t *testing.T) {
+	date := time.Date(2023, time.June, 8, 0, 0, 0, 0, time.UTC)
+	rate := taxes.TaxRate{Type: taxes.TaxTypeSmallBusiness, Rate: 0.01}
+
+	calc := NewCalculation(service.CalculateResponse{
+		TaxRates:   service.AppliedRates{Type: taxes.TaxTypeSmallBusiness, Rates: []taxes.TaxRate{rate}},
+		YearIncome: money("1000", currencies.GEL),
+		Incomes: []service.IncomeResponse{
+			{
+				ConvertResponse: service.ConvertResponse{
+					Date:      date,
+					Amount:    money("1000", currencies.GEL),
+					Converted: money("1000", currencies.GEL),
+					Rate:      money("1", ""),
+					RateDate:  time.Date(2023, time.June, 7, 0, 0, 0, 0, time.UTC),
+				},
+				TaxRate:    rate,
+				Tax:        money("10", currencies.GEL),
+				YearToDate: money("1000", currencies.GEL),
+			},
+		},
+		TotalIncomeConverted: money("1000", currencies.GEL),
+		Tax:                  money("10", currencies.GEL),
+		Rounding:             taxes.DefaultRounding,
+		TaxParts: []taxes.Part{
+			{
+				Base: money("1000", currencies.GEL),
+				Rate: 0.01,
+				Tax:  money("10", currencies.GEL),
+			},
+		},
+	})
+
+	var buf bytes.Buffer
+
+	require.NoError(t, Write(&buf, FormatJSON, calc))
+
+	assert.JSONEq(t, `{
+  "tax_rate": {"type": "Small Business", "rate": "0.01"},
+  "tax_rates": [{"type": "Small Business", "rate": "0.01"}],
+  "year_income": {"amount": "1000", "currency": "GEL"},
+  "incomes": [
+    {
+      "date": "2023-06-08",
+      "amount": {"amount": "1000", "currency": "GEL"},
+      "converted": {"amount": "1000", "currency": "GEL"},
+      "rate": "1",
+      "rate_date": "2023-06-07",
+      "tax_rate": {"type": "Small Business", "rate": "0.01"},
+      "tax": {"amount": "10", "currency": "GEL"},
+      "year_to_date": {"amount": "1000", "currency": "GEL"},
+      "pension": {
+        "employee": {"amount": "0", "currency": ""},
+        "employer": {"amount": "0", "currency": ""},
+        "state": {"amount": "0", "currency": ""},
+        "total": {"amount": "0", "currency": ""}
+      }
+    }
+  ],
+  "total_income": {"amount": "1000", "currency": "GEL"},
+  "tax_parts": [
+    {
+      "base": {"amount": "1000", "currency": "GEL"},
+      "rate": "0.01",
+      "tax": {"amount": "10", "currency": "GEL"},
+      "over_threshold": false
+    }
+  ],
+  "threshold_crossings": [],
+  "pension": {
+    "employee": {"amount": "0", "currency": ""},
+    "employer": {"amount": "0", "currency": ""},
+    "state": {"amount": "0", "currency": ""},
+    "total": {"amount": "0", "currency": ""}
+  },
+  "tax": {"amount": "10", "currency": "GEL"},
+  "rounding": {"policy": "per-income", "mode": "half-up"}
+}`, buf.String())
+
+	buf.Reset()
+
+	require.NoError(t, Write(&buf, FormatCSV, calc))
+
+	assert.Equal(t, "date,amount,currency,converted,converted_currency,rate,tax_rate,tax,year_to_date,pension,rate_date\n"+
+		"2023-06-08,1000,GEL,1000,GEL,1,0.01,10,1000,0,2023-06-07\n", buf.String())
+}
+
+func TestWrite_Declarations(t *testing.T) {
+	decls := NewDeclarations(service.DeclarationsResponse{
+		TaxRates: service.AppliedRates{
+			Type: taxes.TaxTypeSmallBusiness,
+			Rates: []taxes.TaxRate{
+				{Type: taxes.TaxTypeSmallBusiness, Rate: 0.01},
+				{Type: taxes.TaxTypeSmallBusiness, Rate: 0.03},
+			},
+		},
+		Declarations: []service.Declaration{
+			{
+				Period:     time.Date(2023, time.December, 1, 0, 0, 0, 0, time.UTC),
+				Income:     money("1000", currencies.GEL),
+				Tax:        money("10", currencies.GEL),
+				YearIncome: money("21000", currencies.GEL),
+				Deadline:   time.Date(2024, time.January, 15, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		Tax:      money("10", currencies.GEL),
+		Rounding: taxes.Rounding{Policy: taxes.RoundPerPeriod, Mode: models.RoundingHalfEven},
+	})
+
+	var buf bytes.Buffer
+
+	require.NoError(t, Write(&buf, FormatYAML, decls))
+
+	assert.Equal(t, `tax_rate:
+  type: Small Business
+  rate: ""
+tax_rates:
+  - type: Small Business
+    rate: "0.01"
+  - type: Small Business
+    rate: "0.03"
+declarations:
+  - period: 2023-12
+    income:
+      amount: "1000"
+      currency: GEL
+    tax:
+      amount: "10"
+      currency: GEL
+    year_income:
+      amount: "21000"
+      currency: GEL
+    deadline: "2024-01-15"
+tax:
+  amount: "10"
+  currency: GEL
+rounding:
+  policy: per-period
+  mode: half-even
+`, buf.String())
+
+	buf.Reset()
+
+	require.NoError(t, Write(&buf, FormatCSV, decls))
+
+	assert.Equal(t, "period,income,tax,year_income,currency,deadline\n"+
+		"2023-12,1000,10,21000,GEL,2024-01-15\n", buf.String())
+}
+
+func TestWrite_Rates(t *testing.T) {
+	date := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	rates := NewRates(service.RatesResponse{
+		Date: date,
+		Rates: []service.Rate{
+			{Code: currencies.RUB, Name: "Russian Ruble", Quantity: 100, Rate: 2.9213, Diff: -0.0117, ValidFrom: date},
+		},
+	})
+
+	var buf bytes.Buffer
+
+	require.NoError(t, Write(&buf, FormatCSV, rates))
+
+	assert.Equal(t, "code,name,quantity,rate,diff,valid_from\n"+
+		"RUB,Russian Ruble,100,2.9213,-0.0117,2024-03-01\n", buf.String())
+}
+
+func TestWrite_RatesHistory(t *testing.T) {
+	day := func(d int) time.Time {
+		return time.Date(2024, time.March, d, 0, 0, 0, 0, time.UTC)
+	}
+
+	hist := NewRatesHistory(service.RatesHistoryResponse{
+		From: day(1),
+		To:   day(4),
+		Currencies: []service.CurrencyHistory{
+			{
+				Code: currencies.USD,
+				Points: []service.RatePoint{
+					{Date: day(1), Quantity: 1, Rate: 2.65},
+					{Date: day(2), Quantity: 1, Rate: 2.66},
+				},
+				Min:     2.65,
+				Max:     2.66,
+				Average: 2.655,
+			},
+			{
+				Code:   currencies.EUR,
+				Points: []service.RatePoint{},
+			},
+		},
+	})
+
+	var buf bytes.Buffer
+
+	require.NoError(t, Write(&buf, FormatMarkdown, hist))
+
+	assert.Equal(t, "# Official rates history\n\n"+
+		"| Field | Value |\n"+
+		"| --- | --- |\n"+
+		"| From | 2024-03-01 |\n"+
+		"| To | 2024-03-04 |\n"+
+		"\n"+
+		"| code | min | max | average | points |\n"+
+		"| --- | --- | --- | --- | --- |\n"+
+		"| USD | 2.65 | 2.66 | 2.655 | 2 |\n"+
+		"| EUR |  |  |  | 0 |\n", buf.String())
+
+	buf.Reset()
+
+	require.NoError(t, Write(&buf, FormatYAML, hist))
+
+	assert.Equal(t, `from: "2024-03-01"
+to: "2024-03-04"
+currencies:
+  - code: USD
+    min: "2.65"
+    max: "2.66"
+    average: "2.655"
+    points:
+      - date: "2024-03-01"
+        quantity: 1
+        rate: "2.65"
+      - date: "2024-03-02"
+        quantity: 1
+        rate: "2.66"
+  - code: EUR
+    min: ""
+    max: ""
+    average: ""
+    points: []
+`, buf.String())
+}
@@ -0,0 +1,204 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/obalunenko/georgia-tax-calculator/internal/models"
+	"github.com/obalunenko/georgia-tax-calculator/internal/taxes"
+	"github.com/obalunenko/georgia-tax-calculator/pkg/nbggovge/currencies"
+)
+
+const (
+	periodLayout = "2006-01"
+	// deadlineDay is a day of the month following the tax period when declaration
+	// should be filed and tax paid.
+	deadlineDay = 15
+)
+
+// DeclarationsResponse model.
+type DeclarationsResponse struct {
+	TaxRates     AppliedRates
+	Declarations []Declaration
+	Tax          models.Money
+	// Rounding applied to taxes. Tax of each declaration is rounded with its mode.
+	// With taxes.RoundAtEnd policy, tax of declaration is a difference of rounded taxes of year to date,
+	// so taxes of declarations add up to Tax.
+	Rounding taxes.Rounding
+}
+
+// Declaration model.
+type Declaration struct {
+	// Period is the first day of a calendar month the declaration is filed for.
+	Period time.Time
+	// Income received during the period in GEL.
+	Income models.Money
+	// Tax due for the period.
+	Tax models.Money
+	// YearIncome is a cumulative year income at the end of the period.
+	YearIncome models.Money
+	// Deadline is the last day to file declaration and pay tax.
+	Deadline time.Time
+}
+
+func (d Declaration) String() string {
+	var resp string
+
+	resp += fmt.Sprintf("Period: %s\n", d.Period.Format(periodLayout))
+	resp += fmt.Sprintf("Income: %s\n", d.Income.String())
+	resp += fmt.Sprintf("Tax: %s\n", d.Tax.String())
+	resp += fmt.Sprintf("Year Income: %s\n", d.YearIncome.String())
+	resp += fmt.Sprintf("Deadline: %s", d.Deadline.Format(layout))
+
+	return resp
+}
+
+func (c DeclarationsResponse) String() string {
+	var resp strings.Builder
+
+	resp.WriteString(fmt.Sprintf("Tax Rate: %s\n", c.TaxRates.String()))
+
+	if c.Rounding != (taxes.Rounding{}) {
+		resp.WriteString(fmt.Sprintf("Rounding: %s\n", c.Rounding.String()))
+	}
+
+	if len(c.Declarations) != 0 {
+		resp.WriteString("Declarations:\n")
+
+		for i := range c.Declarations {
+			resp.WriteString(fmt.Sprintf("\t- %s:\n", c.Declarations[i].Period.Format(periodLayout)))
+
+			lines := strings.Split(c.Declarations[i].String(), "\n")
+
+			// Period is already printed in the header of the item.
+			for _, line := range lines[1:] {
+				resp.WriteString(fmt.Sprintf("\t\t%s\n", line))
+			}
+		}
+	}
+
+	resp.WriteString(fmt.Sprintf("Taxes: %s", c.Tax.String()))
+
+	return resp.String()
+}
+
+// Declarations calculates taxes and groups incomes by calendar month.
+func (s service) Declarations(ctx context.Context, req CalculateRequest) (*DeclarationsResponse, error) {
+	calc, err := s.Calculate(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Incomes are sorted by date, so declarations are in order of periods.
+	decls, err := groupByPeriod(calc.Incomes)
+	if err != nil {
+		return nil, err
+	}
+
+	// Year income before the first declaration.
+	yi, err := calc.YearIncome.Sub(calc.TotalIncomeConverted)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate year income: %w", err)
+	}
+
+	for i := range decls {
+		if yi, err = yi.Add(decls[i].Income); err != nil {
+			return nil, fmt.Errorf("failed to sum year income: %w", err)
+		}
+
+		decls[i].YearIncome = yi
+	}
+
+	if err = roundDeclarationTaxes(decls, calc.Rounding); err != nil {
+		return nil, fmt.Errorf("failed to round taxes: %w", err)
+	}
+
+	return &DeclarationsResponse{
+		TaxRates:     calc.TaxRates,
+		Declarations: decls,
+		Tax:          calc.Tax,
+		Rounding:     calc.Rounding,
+	}, nil
+}
+
+// roundDeclarationTaxes rounds tax of each declaration with mode of rounding.
+// Rounded taxes of periods do not add up to tax rounded at end, so with taxes.RoundAtEnd policy
+// tax of declaration is a rounded tax of year to date less rounded tax of year to date of previous declaration.
+func roundDeclarationTaxes(decls []Declaration, rounding taxes.Rounding) error {
+	if rounding.Policy != taxes.RoundAtEnd {
+		for i := range decls {
+			decls[i].Tax = decls[i].Tax.RoundWith(rounding.Mode)
+		}
+
+		return nil
+	}
+
+	exact := models.NewMoney(decimal.Zero, currencies.GEL)
+	declared := exact
+
+	for i := range decls {
+		var err error
+
+		if exact, err = exact.Add(decls[i].Tax); err != nil {
+			return err
+		}
+
+		rounded := exact.RoundWith(rounding.Mode)
+
+		if decls[i].Tax, err = rounded.Sub(declared); err != nil {
+			return err
+		}
+
+		declared = rounded
+	}
+
+	return nil
+}
+
+// groupByPeriod sums income and tax of incomes by calendar month in order of the first income of month.
+func groupByPeriod(incomes []IncomeResponse) ([]Declaration, error) {
+	decls := make([]Declaration, 0, len(incomes))
+
+	for i := range incomes {
+		inc := incomes[i]
+
+		period := periodStart(inc.Date)
+
+		idx := slices.IndexFunc(decls, func(d Declaration) bool {
+			return d.Period.Equal(period)
+		})
+
+		if idx == -1 {
+			decls = append(decls, Declaration{
+				Period:   period,
+				Income:   models.NewMoney(decimal.Zero, currencies.GEL),
+				Tax:      models.NewMoney(decimal.Zero, currencies.GEL),
+				Deadline: time.Date(period.Year(), period.Month()+1, deadlineDay, 0, 0, 0, 0, time.UTC),
+			})
+
+			idx = len(decls) - 1
+		}
+
+		var err error
+
+		if decls[idx].Income, err = decls[idx].Income.Add(inc.Converted); err != nil {
+			return nil, fmt.Errorf("failed to sum incomes: %w", err)
+		}
+
+		if decls[idx].Tax, err = decls[idx].Tax.Add(inc.Tax); err != nil {
+			return nil, fmt.Errorf("failed to sum taxes: %w", err)
+		}
+	}
+
+	return decls, nil
+}
+
+// periodStart returns the first day of the month of date.
+func periodStart(date time.Time) time.Time {
+	return time.Date(date.Year(), date.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,152 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/obalunenko/georgia-tax-calculator/internal/taxes"
+	"github.com/obalunenko/georgia-tax-calculator/pkg/nbggovge/currencies"
+)
+
+func newIncome(year, month, day, amount string) Income {
+	return Income{
+		DateRequest: DateRequest{
+			Year:  year,
+			Month: month,
+			Day:   day,
+		},
+		Currency: currencies.USD,
+		Amount:   amount,
+	}
+}
+
+func Test_service_Declarations(t *testing.T) {
+	s := service{c: mockConverter{}}
+
+	got, err := s.Declarations(context.Background(), CalculateRequest{
+		Income: []Income{
+			newIncome("2023", "May", "08", "10000"),
+			newIncome("2023", "May", "20", "5000"),
+			newIncome("2023", "June", "08", "20000"),
+			newIncome("2023", "December", "01", "1000"),
+		},
+		TaxType:    taxes.TaxTypeSmallBusiness.String(),
+		YearIncome: "480000",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, &DeclarationsResponse{
+		TaxRates: AppliedRates{
+			Type:  taxes.TaxTypeSmallBusiness,
+			Rates: []taxes.TaxRate{{Type: taxes.TaxTypeSmallBusiness, Rate: 0.01}},
+		},
+		Declarations: []Declaration{
+			{
+				Period:     time.Date(2023, time.May, 1, 0, 0, 0, 0, time.UTC),
+				Income:     money("15000", currencies.GEL),
+				Tax:        money("150", currencies.GEL),
+				YearIncome: money("495000", currencies.GEL),
+				Deadline:   time.Date(2023, time.June, 15, 0, 0, 0, 0, time.UTC),
+			},
+			{
+				Period:     time.Date(2023, time.June, 1, 0, 0, 0, 0, time.UTC),
+				Income:     money("20000", currencies.GEL),
+				Tax:        money("500", currencies.GEL),
+				YearIncome: money("515000", currencies.GEL),
+				Deadline:   time.Date(2023, time.July, 15, 0, 0, 0, 0, time.UTC),
+			},
+			{
+				Period:     time.Date(2023, time.December, 1, 0, 0, 0, 0, time.UTC),
+				Income:     money("1000", currencies.GEL),
+				Tax:        money("30", currencies.GEL),
+				YearIncome: money("516000", currencies.GEL),
+				Deadline:   time.Date(2024, time.January, 15, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		Tax:      money("680", currencies.GEL),
+		Rounding: taxes.DefaultRounding,
+	}, got)
+
+	_, err = service{c: mockConverterError{}}.Declarations(context.Background(), CalculateRequest{
+		Income:     []Income{newIncome("2023", "May", "08", "10000")},
+		TaxType:    taxes.TaxTypeSmallBusiness.String(),
+		YearIncome: "0",
+	})
+	require.Error(t, err)
+}
+
+func Test_service_Declarations_OutOfOrder(t *testing.T) {
+	s := service{c: mockConverter{}}
+
+	req := func(incomes ...Income) CalculateRequest {
+		return CalculateRequest{
+			Income:     incomes,
+			TaxType:    taxes.TaxTypeSmallBusiness.String(),
+			YearIncome: "480000",
+		}
+	}
+
+	may := newIncome("2023", "May", "08", "10000")
+	june := newIncome("2023", "June", "08", "20000")
+	december := newIncome("2023", "December", "01", "1000")
+
+	want, err := s.Declarations(context.Background(), req(may, june, december))
+	require.NoError(t, err)
+
+	// December income is taxed after the threshold was exceeded in June, wherever it is in input.
+	got, err := s.Declarations(context.Background(), req(december, june, may))
+	require.NoError(t, err)
+
+	assert.Equal(t, want, got)
+
+	calc, err := s.Calculate(context.Background(), req(december, june, may))
+	require.NoError(t, err)
+	require.Len(t, calc.Incomes, 3)
+	require.NotEmpty(t, calc.ThresholdCrossings)
+
+	assert.Equal(t, time.Date(2023, time.May, 8, 0, 0, 0, 0, time.UTC), calc.Incomes[0].Date)
+	assert.Equal(t, money("490000", currencies.GEL), calc.Incomes[0].YearToDate)
+	assert.Equal(t, money("30", currencies.GEL), calc.Incomes[2].Tax)
+	assert.Equal(t, ThresholdCrossing{
+		Number: 2,
+		Date:   time.Date(2023, time.June, 8, 0, 0, 0, 0, time.UTC),
+		Parts:  calc.ThresholdCrossings[0].Parts,
+	}, calc.ThresholdCrossings[0])
+
+	_, err = s.Declarations(context.Background(), req(may, newIncome("2024", "January", "10", "1000")))
+	require.ErrorIs(t, err, ErrDifferentYears)
+}
+
+func TestDeclarationsResponse_String(t *testing.T) {
+	resp := DeclarationsResponse{
+		TaxRates: AppliedRates{
+			Type:  taxes.TaxTypeSmallBusiness,
+			Rates: []taxes.TaxRate{{Type: taxes.TaxTypeSmallBusiness, Rate: 0.01}},
+		},
+		Declarations: []Declaration{
+			{
+				Period:     time.Date(2023, time.December, 1, 0, 0, 0, 0, time.UTC),
+				Income:     money("1000", currencies.GEL),
+				Tax:        money("10", currencies.GEL),
+				YearIncome: money("21000", currencies.GEL),
+				Deadline:   time.Date(2024, time.January, 15, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		Tax: money("10", currencies.GEL),
+	}
+
+	want := "Tax Rate: Small Business 1 %\n" +
+		"Declarations:\n" +
+		"\t- 2023-12:\n" +
+		"\t\tIncome: 1000.00 GEL\n" +
+		"\t\tTax: 10.00 GEL\n" +
+		"\t\tYear Income: 21000.00 GEL\n" +
+		"\t\tDeadline: 2024-01-15\n" +
+		"Taxes: 10.00 GEL"
+
+	assert.Equal(t, want, resp.String())
+}
@@ -0,0 +1,300 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/obalunenko/georgia-tax-calculator/internal/spinner"
+	"github.com/obalunenko/georgia-tax-calculator/pkg/moneyutils"
+	"github.com/obalunenko/georgia-tax-calculator/pkg/nbggovge"
+	"github.com/obalunenko/georgia-tax-calculator/pkg/nbggovge/currencies"
+	"github.com/obalunenko/georgia-tax-calculator/pkg/nbggovge/option"
+)
+
+// ratePlaces is a number of decimal places of rates published by NBG.
+const ratePlaces = 4
+
+// RatesRequest model.
+type RatesRequest struct {
+	// Date of rates, zero date means today.
+	Date time.Time
+	// Currencies are codes of currencies, no codes mean all published currencies.
+	Currencies []string
+}
+
+// Rate is an official rate of currency.
+type Rate struct {
+	Code string
+	Name string
+	// Quantity is a number of currency units the rate is published for.
+	Quantity int64
+	// Rate is a price of Quantity units in GEL.
+	Rate float64
+	// Diff is a change of rate since the previous publication.
+	Diff float64
+	// ValidFrom is a date since the rate is valid.
+	ValidFrom time.Time
+}
+
+func newRate(c nbggovge.Currency) (Rate, error) {
+	validFrom, err := c.ValidFrom()
+	if err != nil {
+		return Rate{}, err
+	}
+
+	return Rate{
+		Code:      c.Code,
+		Name:      c.Name,
+		Quantity:  c.Quantity,
+		Rate:      c.Rate,
+		Diff:      c.Diff,
+		ValidFrom: validFrom,
+	}, nil
+}
+
+// RatesResponse model.
+type RatesResponse struct {
+	Date  time.Time
+	Rates []Rate
+}
+
+func (c RatesResponse) String() string {
+	var resp strings.Builder
+
+	resp.WriteString(fmt.Sprintf("Date: %s\n", c.Date.Format(layout)))
+
+	tw := newTabWriter(&resp)
+
+	_, _ = fmt.Fprintln(tw, "Code\tQuantity\tRate\tDiff\tValid From")
+
+	for _, r := range c.Rates {
+		_, _ = fmt.Fprintf(tw, "%s\t%d\t%.4f\t%+.4f\t%s\n", r.Code, r.Quantity, r.Rate, r.Diff, r.ValidFrom.Format(layout))
+	}
+
+	_ = tw.Flush()
+
+	return strings.TrimSuffix(resp.String(), "\n")
+}
+
+// RatesHistoryRequest model.
+type RatesHistoryRequest struct {
+	From time.Time
+	To   time.Time
+	// Currencies are codes of currencies, no codes mean all published currencies.
+	Currencies []string
+}
+
+// RatePoint is a rate published in history.
+type RatePoint struct {
+	// Date since the rate is valid.
+	Date     time.Time
+	Quantity int64
+	Rate     float64
+}
+
+// CurrencyHistory is a history of rates of currency with its statistics.
+type CurrencyHistory struct {
+	Code string
+	// Points are sorted by date. No points mean no rates published in range.
+	Points []RatePoint
+	// Min, Max and Average are rates of one unit of currency, as quantity could change in range.
+	Min     float64
+	Max     float64
+	Average float64
+}
+
+func newCurrencyHistory(code string, points []nbggovge.RatePoint) CurrencyHistory {
+	h := CurrencyHistory{
+		Code:   code,
+		Points: make([]RatePoint, 0, len(points)),
+	}
+
+	var (
+		sum         decimal.Decimal
+		maxQuantity int64 = 1
+	)
+
+	for i, p := range points {
+		h.Points = append(h.Points, RatePoint{Date: p.Date, Quantity: p.Quantity, Rate: p.Rate})
+
+		quantity := max(p.Quantity, 1)
+		maxQuantity = max(maxQuantity, quantity)
+
+		rate := moneyutils.Div(moneyutils.FromFloat(p.Rate), decimal.NewFromInt(quantity))
+		unit := moneyutils.ToFloat(rate)
+
+		if i == 0 || unit < h.Min {
+			h.Min = unit
+		}
+
+		if i == 0 || unit > h.Max {
+			h.Max = unit
+		}
+
+		sum = moneyutils.Add(sum, rate)
+	}
+
+	if len(points) != 0 {
+		avg := moneyutils.Div(sum, decimal.NewFromInt(int64(len(points))))
+
+		// Rate of one unit has as many more decimal places as zeros in quantity, e.g. 0.0185 for 100 units at 1.85.
+		places := ratePlaces + int32(len(strconv.FormatInt(maxQuantity, 10))-1)
+
+		h.Average = moneyutils.ToFloat(moneyutils.Round(avg, places))
+	}
+
+	return h
+}
+
+// RatesHistoryResponse model.
+type RatesHistoryResponse struct {
+	From       time.Time
+	To         time.Time
+	Currencies []CurrencyHistory
+}
+
+func (c RatesHistoryResponse) String() string {
+	var resp strings.Builder
+
+	resp.WriteString(fmt.Sprintf("From: %s\n", c.From.Format(layout)))
+	resp.WriteString(fmt.Sprintf("To: %s\n", c.To.Format(layout)))
+
+	for _, h := range c.Currencies {
+		resp.WriteString(fmt.Sprintf("\n%s:\n", h.Code))
+
+		if len(h.Points) == 0 {
+			resp.WriteString("  No rates published\n")
+
+			continue
+		}
+
+		tw := newTabWriter(&resp)
+
+		for _, p := range h.Points {
+			_, _ = fmt.Fprintf(tw, "\t%s\t%d\t%.4f\n", p.Date.Format(layout), p.Quantity, p.Rate)
+		}
+
+		_ = tw.Flush()
+
+		resp.WriteString(fmt.Sprintf("  Min: %.4f, Max: %.4f, Average: %.4f\n", h.Min, h.Max, h.Average))
+	}
+
+	return strings.TrimSuffix(resp.String(), "\n")
+}
+
+func newTabWriter(b *strings.Builder) *tabwriter.Writer {
+	const (
+		minWidth = 0
+		tabWidth = 0
+		padding  = 2
+	)
+
+	return tabwriter.NewWriter(b, minWidth, tabWidth, padding, ' ', 0)
+}
+
+// Rates returns official rates of currencies published for date.
+func (s service) Rates(ctx context.Context, req RatesRequest) (*RatesResponse, error) {
+	stop := spinner.Start("Fetching rates", "Fetched rates")
+	defer stop()
+
+	date := req.Date
+	if date.IsZero() {
+		date = time.Now()
+	}
+
+	opts := []option.RatesOption{option.WithDate(date)}
+
+	for _, code := range req.Currencies {
+		opts = append(opts, option.WithCurrency(code))
+	}
+
+	rates, err := s.client.Rates(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch rates: %w", err)
+	}
+
+	for _, code := range req.Currencies {
+		if _, err = rates.CurrencyByCode(code); err != nil {
+			return nil, err
+		}
+	}
+
+	resp := RatesResponse{
+		Date:  date,
+		Rates: make([]Rate, 0, len(rates.Currencies)),
+	}
+
+	for _, c := range rates.Currencies {
+		if omitted(req.Currencies, c.Code) {
+			continue
+		}
+
+		r, err := newRate(c)
+		if err != nil {
+			return nil, err
+		}
+
+		resp.Rates = append(resp.Rates, r)
+	}
+
+	return &resp, nil
+}
+
+// RatesHistory returns history of official rates of currencies published from one date to another inclusive
+// along with minimum, maximum and average rate of each currency.
+func (s service) RatesHistory(ctx context.Context, req RatesHistoryRequest) (*RatesHistoryResponse, error) {
+	stop := spinner.Start("Fetching rates history", "Fetched rates history")
+	defer stop()
+
+	opts := make([]option.RatesOption, 0, len(req.Currencies))
+
+	for _, code := range req.Currencies {
+		opts = append(opts, option.WithCurrency(code))
+	}
+
+	history, err := nbggovge.RatesRange(ctx, s.client, req.From, req.To, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch rates history: %w", err)
+	}
+
+	codes := slices.Clone(req.Currencies)
+
+	for code := range history {
+		if !containsCode(codes, code) && !omitted(req.Currencies, code) {
+			codes = append(codes, code)
+		}
+	}
+
+	slices.Sort(codes)
+
+	resp := RatesHistoryResponse{
+		From:       req.From,
+		To:         req.To,
+		Currencies: make([]CurrencyHistory, 0, len(codes)),
+	}
+
+	for _, code := range codes {
+		resp.Currencies = append(resp.Currencies, newCurrencyHistory(code, history[code]))
+	}
+
+	return &resp, nil
+}
+
+// omitted reports whether rates of currency with code are left out of response for requested codes.
+// GEL is included only when it is requested, as its rate is always 1.
+func omitted(requested []string, code string) bool {
+	return strings.EqualFold(code, currencies.GEL) && !containsCode(requested, code)
+}
+
+func containsCode(codes []string, code string) bool {
+	return slices.ContainsFunc(codes, func(c string) bool {
+		return strings.EqualFold(c, code)
+	})
+}
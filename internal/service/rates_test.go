@@ -0,0 +1,128 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/obalunenko/georgia-tax-calculator/pkg/nbggovge"
+	"github.com/obalunenko/georgia-tax-calculator/pkg/nbggovge/currencies"
+	"github.com/obalunenko/georgia-tax-calculator/pkg/nbggovge/mock"
+)
+
+func ratesDate(day int) time.Time {
+	return time.Date(2024, time.March, day, 0, 0, 0, 0, time.UTC)
+}
+
+// newRatesService returns service with rates published on 2024-03-01 (Friday) and 2024-03-04 (Monday).
+// Rates published on Monday are valid since Saturday.
+func newRatesService() Service {
+	published := func(date, validFrom string, usd, eur float64) nbggovge.Rates {
+		return nbggovge.Rates{
+			Date: date + "T00:00:00.000Z",
+			Currencies: []nbggovge.Currency{
+				{Code: currencies.EUR, Quantity: 1, Rate: eur, Diff: 0.01, ValidFromDate: validFrom + "T00:00:00.000Z"},
+				{Code: currencies.USD, Quantity: 1, Rate: usd, Diff: -0.002, ValidFromDate: validFrom + "T00:00:00.000Z"},
+			},
+		}
+	}
+
+	return NewWithClient(mock.NewHistoryClient([]nbggovge.Rates{
+		published("2024-03-01", "2024-03-01", 2.65, 2.87),
+		published("2024-03-04", "2024-03-02", 2.66, 2.89),
+	}))
+}
+
+func Test_service_Rates(t *testing.T) {
+	ctx := context.Background()
+
+	resp, err := newRatesService().Rates(ctx, RatesRequest{Date: ratesDate(1)})
+	require.NoError(t, err)
+
+	assert.Equal(t, &RatesResponse{
+		Date: ratesDate(1),
+		Rates: []Rate{
+			{Code: currencies.EUR, Quantity: 1, Rate: 2.87, Diff: 0.01, ValidFrom: ratesDate(1)},
+			{Code: currencies.USD, Quantity: 1, Rate: 2.65, Diff: -0.002, ValidFrom: ratesDate(1)},
+		},
+	}, resp)
+
+	assert.Equal(t, "Date: 2024-03-01\n"+
+		"Code  Quantity  Rate    Diff     Valid From\n"+
+		"EUR   1         2.8700  +0.0100  2024-03-01\n"+
+		"USD   1         2.6500  -0.0020  2024-03-01", resp.String())
+
+	_, err = newRatesService().Rates(ctx, RatesRequest{Date: ratesDate(1), Currencies: []string{currencies.GBP}})
+
+	var uerr *nbggovge.UnknownCurrencyError
+
+	require.ErrorAs(t, err, &uerr)
+	assert.Equal(t, currencies.GBP, uerr.Code)
+}
+
+func Test_service_RatesHistory(t *testing.T) {
+	resp, err := newRatesService().RatesHistory(context.Background(), RatesHistoryRequest{
+		From: time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC),
+		To:   ratesDate(4),
+	})
+	require.NoError(t, err)
+
+	require.Len(t, resp.Currencies, 2)
+
+	usd := resp.Currencies[1]
+
+	assert.Equal(t, CurrencyHistory{
+		Code: currencies.USD,
+		Points: []RatePoint{
+			{Date: ratesDate(1), Quantity: 1, Rate: 2.65},
+			{Date: ratesDate(2), Quantity: 1, Rate: 2.66},
+		},
+		Min:     2.65,
+		Max:     2.66,
+		Average: 2.655,
+	}, usd)
+
+	assert.Equal(t, "From: 2024-02-29\n"+
+		"To: 2024-03-04\n"+
+		"\n"+
+		"EUR:\n"+
+		"  2024-03-01  1  2.8700\n"+
+		"  2024-03-02  1  2.8900\n"+
+		"  Min: 2.8700, Max: 2.8900, Average: 2.8800\n"+
+		"\n"+
+		"USD:\n"+
+		"  2024-03-01  1  2.6500\n"+
+		"  2024-03-02  1  2.6600\n"+
+		"  Min: 2.6500, Max: 2.6600, Average: 2.6550", resp.String())
+}
+
+func Test_service_RatesHistory_NoRates(t *testing.T) {
+	resp, err := newRatesService().RatesHistory(context.Background(), RatesHistoryRequest{
+		From:       time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC),
+		To:         time.Date(2024, time.February, 2, 0, 0, 0, 0, time.UTC),
+		Currencies: []string{currencies.USD},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []CurrencyHistory{{Code: currencies.USD, Points: []RatePoint{}}}, resp.Currencies)
+	assert.Contains(t, resp.String(), "USD:\n  No rates published")
+}
+
+func Test_newCurrencyHistory_QuantityChange(t *testing.T) {
+	h := newCurrencyHistory(currencies.JPY, []nbggovge.RatePoint{
+		{Date: ratesDate(1), Currency: nbggovge.Currency{Code: currencies.JPY, Quantity: 100, Rate: 1.8}},
+		{Date: ratesDate(4), Currency: nbggovge.Currency{Code: currencies.JPY, Quantity: 10, Rate: 0.19}},
+	})
+
+	// Rates are compared per unit: 0.018 and 0.019 GEL, though 0.19 is less than 1.8.
+	assert.InDelta(t, 0.018, h.Min, 1e-9)
+	assert.InDelta(t, 0.019, h.Max, 1e-9)
+	assert.InDelta(t, 0.0185, h.Average, 1e-9)
+	assert.Equal(t, []RatePoint{
+		{Date: ratesDate(1), Quantity: 100, Rate: 1.8},
+		{Date: ratesDate(4), Quantity: 10, Rate: 0.19},
+	}, h.Points)
+}
@@ -3,10 +3,17 @@
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/shopspring/decimal"
+
 	"github.com/obalunenko/georgia-tax-calculator/internal/converter"
 	"github.com/obalunenko/georgia-tax-calculator/internal/models"
 	"github.com/obalunenko/georgia-tax-calculator/internal/spinner"
@@ -21,11 +28,17 @@
 	layout = "2006-01-02"
 )
 
+// ErrDifferentYears returned when incomes are received in different calendar years.
+var ErrDifferentYears = errors.New("incomes are received in different calendar years")
+
 // CalculateRequest model.
 type CalculateRequest struct {
+	// Income received in one calendar year. Incomes are taxed in order of their dates.
 	Income     []Income
 	TaxType    string `survey:"tax_type"`
 	YearIncome string `survey:"year_income"`
+	// Rounding of taxes. Zero value means taxes.DefaultRounding.
+	Rounding taxes.Rounding
 }
 
 // Income model.
@@ -33,6 +46,8 @@ type Income struct {
 	DateRequest
 	Currency string `survey:"currency"`
 	Amount   string `survey:"amount"`
+	// Category of income, e.g. royalty. Regimes may tax categories differently, empty means general income.
+	Category string `survey:"category"`
 }
 
 // DateRequest model.
@@ -42,23 +57,140 @@ type DateRequest struct {
 	Day   string `survey:"day"`
 }
 
+// NewDateRequest is a DateRequest constructor.
+func NewDateRequest(date time.Time) DateRequest {
+	return DateRequest{
+		Year:  strconv.Itoa(date.Year()),
+		Month: date.Month().String(),
+		Day:   fmt.Sprintf("%02d", date.Day()),
+	}
+}
+
 func (d DateRequest) String() string {
 	return fmt.Sprintf("%s-%s-%s", d.Year, d.Month, d.Day)
 }
 
+// Date parses DateRequest to date in UTC.
+func (d DateRequest) Date() (time.Time, error) {
+	year, err := dateutils.ParseYear(d.Year)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	month, err := dateutils.ParseMonth(d.Month)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	day, err := dateutils.ParseDay(d.Day)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC), nil
+}
+
+// AppliedRates are distinct tax rates of tax type applied to incomes, in order of incomes.
+// There are several rates when incomes span a change of the rate.
+type AppliedRates struct {
+	Type  taxes.TaxType
+	Rates []taxes.TaxRate
+}
+
+// String returns applied rates separated by comma, or tax type when no rate was applied.
+func (a AppliedRates) String() string {
+	if len(a.Rates) == 0 {
+		return a.Type.String()
+	}
+
+	rates := make([]string, 0, len(a.Rates))
+
+	for _, r := range a.Rates {
+		rates = append(rates, r.String())
+	}
+
+	return strings.Join(rates, ", ")
+}
+
+func (a *AppliedRates) add(tr taxes.TaxRate) {
+	if !slices.Contains(a.Rates, tr) {
+		a.Rates = append(a.Rates, tr)
+	}
+}
+
 // CalculateResponse model.
 type CalculateResponse struct {
-	TaxRate              taxes.TaxRate
+	// TaxRates applied to incomes, rate of each income is in IncomeResponse.TaxRate.
+	TaxRates             AppliedRates
 	YearIncome           models.Money
-	Incomes              []ConvertResponse
+	Incomes              []IncomeResponse
 	TotalIncomeConverted models.Money
 	Tax                  models.Money
+	// Pension holds total funded pension contributions.
+	Pension taxes.Pension
+	// TaxParts holds total income and tax amounts grouped by applied rate.
+	TaxParts []taxes.Part
+	// ThresholdCrossings holds incomes that were taxed above the annual threshold.
+	ThresholdCrossings []ThresholdCrossing
+	// Rounding applied to taxes, so that calculation could be reproduced.
+	Rounding taxes.Rounding
+}
+
+// ThresholdCrossing model.
+type ThresholdCrossing struct {
+	// Number of income in CalculateResponse.Incomes starting from 1.
+	Number int
+	Date   time.Time
+	Parts  []taxes.Part
+}
+
+// IncomeResponse model.
+type IncomeResponse struct {
+	ConvertResponse
+	// TaxRate applied to income.
+	TaxRate taxes.TaxRate
+	// Tax charged on income. It is exact unless taxes are rounded per income, see taxes.Rounding.
+	Tax models.Money
+	// YearToDate is a year income including this income.
+	YearToDate models.Money
+	Pension    taxes.Pension
+}
+
+// format returns income as text with tax rounded with mode.
+func (c IncomeResponse) format(mode models.RoundingMode) string {
+	resp := c.ConvertResponse.String()
+
+	resp += fmt.Sprintf("\nTax Rate: %s", c.TaxRate.String())
+	resp += fmt.Sprintf("\nTax: %s", c.Tax.StringWith(mode))
+	resp += fmt.Sprintf("\nYear To Date: %s", c.YearToDate.String())
+
+	if !c.Pension.IsZero() {
+		resp += "\n" + formatPension(c.Pension)
+	}
+
+	return resp
+}
+
+func formatPension(p taxes.Pension) string {
+	var resp strings.Builder
+
+	resp.WriteString("Pension Contributions:\n")
+	resp.WriteString(fmt.Sprintf("\tEmployee: %s\n", p.Employee.String()))
+	resp.WriteString(fmt.Sprintf("\tEmployer: %s\n", p.Employer.String()))
+	resp.WriteString(fmt.Sprintf("\tState: %s\n", p.State.String()))
+	resp.WriteString(fmt.Sprintf("\tTotal: %s", p.Total().String()))
+
+	return resp.String()
 }
 
 func (c CalculateResponse) String() string {
 	var resp strings.Builder
 
-	resp.WriteString(fmt.Sprintf("Tax Rate: %s\n", c.TaxRate.String()))
+	resp.WriteString(fmt.Sprintf("Tax Rate: %s\n", c.TaxRates.String()))
+
+	if c.Rounding != (taxes.Rounding{}) {
+		resp.WriteString(fmt.Sprintf("Rounding: %s\n", c.Rounding.String()))
+	}
 
 	resp.WriteString(fmt.Sprintf("Year Income: %s\n", c.YearIncome.String()))
 
@@ -68,7 +200,7 @@ func (c CalculateResponse) String() string {
 		for i := range c.Incomes {
 			inc := c.Incomes[i]
 
-			raw := inc.String()
+			raw := inc.format(c.Rounding.Mode)
 
 			if strings.TrimSpace(raw) == "" {
 				continue
@@ -88,6 +220,31 @@ func (c CalculateResponse) String() string {
 
 	resp.WriteString(fmt.Sprintf("Total Income Converted: %s\n", c.TotalIncomeConverted.String()))
 
+	if len(c.TaxParts) != 0 {
+		resp.WriteString("Taxed at rates:\n")
+
+		for _, p := range c.TaxParts {
+			resp.WriteString(fmt.Sprintf("\t- %s\n", p.StringWith(c.Rounding.Mode)))
+		}
+	}
+
+	if len(c.ThresholdCrossings) != 0 {
+		resp.WriteString("Annual threshold exceeded:\n")
+
+		for _, cr := range c.ThresholdCrossings {
+			resp.WriteString(fmt.Sprintf("\t- %d (%s):\n", cr.Number, cr.Date.Format(layout)))
+
+			for _, p := range cr.Parts {
+				resp.WriteString(fmt.Sprintf("\t\t%s\n", p.StringWith(c.Rounding.Mode)))
+			}
+		}
+	}
+
+	if !c.Pension.IsZero() {
+		resp.WriteString(formatPension(c.Pension))
+		resp.WriteString("\n")
+	}
+
 	resp.WriteString(fmt.Sprintf("Taxes: %s", c.Tax.String()))
 
 	return resp.String()
@@ -107,6 +264,9 @@ type ConvertResponse struct {
 	Amount    models.Money
 	Converted models.Money
 	Rate      models.Money
+	// RateDate is a date since the rate is valid. It differs from Date when no rate was published
+	// for Date, e.g. on weekends and holidays, and the previous published rate is used.
+	RateDate time.Time
 }
 
 func (c ConvertResponse) String() string {
@@ -117,6 +277,10 @@ func (c ConvertResponse) String() string {
 	resp += fmt.Sprintf("Converted: %s\n", c.Converted.String())
 	resp += fmt.Sprintf("Rate: %s", c.Rate.String())
 
+	if !c.RateDate.IsZero() {
+		resp += fmt.Sprintf("\nRate Date: %s", c.RateDate.Format(layout))
+	}
+
 	return resp
 }
 
@@ -124,6 +288,8 @@ func (c ConvertResponse) String() string {
 type Service interface {
 	Converter
 	TaxCalculator
+	Declarer
+	RatesViewer
 }
 
 // Converter converts currencies.
@@ -136,18 +302,59 @@ type TaxCalculator interface {
 	Calculate(ctx context.Context, p CalculateRequest) (*CalculateResponse, error)
 }
 
+// Declarer builds monthly tax declarations.
+type Declarer interface {
+	Declarations(ctx context.Context, p CalculateRequest) (*DeclarationsResponse, error)
+}
+
+// RatesViewer shows official rates of currencies.
+type RatesViewer interface {
+	Rates(ctx context.Context, p RatesRequest) (*RatesResponse, error)
+	RatesHistory(ctx context.Context, p RatesHistoryRequest) (*RatesHistoryResponse, error)
+}
+
+const cacheDirName = "georgia-tax-calculator"
+
+// DefaultCacheDir returns directory for rates cache in user cache directory.
+// Returns empty string when user cache directory is not available.
+func DefaultCacheDir() string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return ""
+	}
+
+	return filepath.Join(dir, cacheDirName, "rates")
+}
+
 type service struct {
-	c converter.Converter
+	c      converter.Converter
+	client nbggovge.Client
 }
 
-// New is a Service constructor.
+// New is a Service constructor. Rates are cached in DefaultCacheDir.
 func New() Service {
-	client := nbggovge.NewCached()
+	return NewWithCacheDir(DefaultCacheDir())
+}
+
+// NewWithCacheDir is a Service constructor with rates cached in dir.
+// When dir is empty, rates are cached in memory only.
+func NewWithCacheDir(dir string) Service {
+	var opts []nbggovge.CacheOption
 
+	if dir != "" {
+		opts = append(opts, nbggovge.WithCacheDir(dir))
+	}
+
+	return NewWithClient(nbggovge.NewCached(opts...))
+}
+
+// NewWithClient is a Service constructor with rates fetched by client.
+func NewWithClient(client nbggovge.Client) Service {
 	c := converter.NewConverter(client)
 
 	return service{
-		c: c,
+		c:      c,
+		client: client,
 	}
 }
 
@@ -158,30 +365,16 @@ func (s service) Convert(ctx context.Context, p ConvertRequest) (*ConvertRespons
 	stop := spinner.Start(name, finalMsg)
 	defer stop()
 
-	year, err := dateutils.ParseYear(p.Year)
-	if err != nil {
-		return nil, err
-	}
-
-	month, err := dateutils.ParseMonth(p.Month)
+	date, err := p.Date()
 	if err != nil {
 		return nil, err
 	}
 
-	day, err := dateutils.ParseDay(p.Day)
-	if err != nil {
-		return nil, err
-	}
-
-	date := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
-
-	mv, err := moneyutils.Parse(p.Amount)
+	amount, err := parseMoney(p.Amount, p.CurrencyFrom)
 	if err != nil {
 		return nil, err
 	}
 
-	amount := models.NewMoney(mv, p.CurrencyFrom)
-
 	converted, err := s.convert(ctx, convertParams{
 		date:  date,
 		m:     amount,
@@ -198,9 +391,24 @@ func (s service) Convert(ctx context.Context, p ConvertRequest) (*ConvertRespons
 		Amount:    amount,
 		Converted: converted.Money,
 		Rate:      rate,
+		RateDate:  converted.RateDate,
 	}, nil
 }
 
+// parseMoney parses amount in currency. Currency written along with amount, e.g. $100, must be the same.
+func parseMoney(raw, currency string) (models.Money, error) {
+	a, err := moneyutils.ParseAmount(raw)
+	if err != nil {
+		return models.Money{}, err
+	}
+
+	if a.Currency != "" && !strings.EqualFold(a.Currency, currency) {
+		return models.Money{}, fmt.Errorf("amount %q is in %s, not in %s: %w", raw, a.Currency, currency, models.ErrCurrencyMismatch)
+	}
+
+	return models.NewMoney(a.Value, currency), nil
+}
+
 // Calculate calculates taxes amount.
 func (s service) Calculate(ctx context.Context, req CalculateRequest) (*CalculateResponse, error) {
 	tt, err := taxes.ParseTaxType(req.TaxType)
@@ -208,64 +416,241 @@ func (s service) Calculate(ctx context.Context, req CalculateRequest) (*Calculat
 		return nil, fmt.Errorf("failed to parse tax type: %w", err)
 	}
 
-	tr, err := tt.Rate()
+	yi, err := parseMoney(req.YearIncome, currencies.GEL)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get tax rate: %w", err)
+		return nil, fmt.Errorf("failed to parse year income: %w", err)
 	}
 
-	yi, err := moneyutils.Parse(req.YearIncome)
+	incomes, err := sortIncomes(req.Income)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse year income: %w", err)
+		return nil, err
 	}
 
-	var (
-		inc float64
-		txs float64
-	)
+	rounding := req.Rounding.OrDefault()
 
-	incomes := make([]ConvertResponse, 0, len(req.Income))
+	totals := newCalcTotals(tt, yi, len(incomes))
 
-	for _, p := range req.Income {
-		r := ConvertRequest{
-			DateRequest: DateRequest{
-				Year:  p.Year,
-				Month: p.Month,
-				Day:   p.Day,
-			},
-			CurrencyFrom: p.Currency,
-			CurrencyTo:   currencies.GEL,
-			Amount:       p.Amount,
+	for _, p := range incomes {
+		inc, tax, err := s.calcIncome(ctx, p, tt, totals.yearIncome, rounding)
+		if err != nil {
+			return nil, err
+		}
+
+		if err = totals.add(inc, tax); err != nil {
+			return nil, fmt.Errorf("failed to sum incomes: %w", err)
 		}
+	}
+
+	tax, err := roundTotalTax(totals, rounding)
+	if err != nil {
+		return nil, fmt.Errorf("failed to round taxes: %w", err)
+	}
+
+	return &CalculateResponse{
+		TaxRates:             totals.rates,
+		YearIncome:           totals.yearIncome,
+		Incomes:              totals.incomes,
+		TotalIncomeConverted: totals.income,
+		Tax:                  tax,
+		Pension:              totals.pension,
+		TaxParts:             totals.parts,
+		ThresholdCrossings:   totals.crossings,
+		Rounding:             rounding,
+	}, nil
+}
+
+// sortIncomes returns incomes sorted by date, so each income is taxed considering incomes received before it.
+// Year income is accumulated within a calendar year, so incomes of different years are rejected.
+func sortIncomes(incomes []Income) ([]Income, error) {
+	type datedIncome struct {
+		Income
+		date time.Time
+	}
+
+	dated := make([]datedIncome, 0, len(incomes))
+
+	for i, p := range incomes {
+		date, err := p.Date()
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse date of income %d: %w", i+1, err)
+		}
+
+		dated = append(dated, datedIncome{Income: p, date: date})
+	}
+
+	slices.SortStableFunc(dated, func(a, b datedIncome) int {
+		return a.date.Compare(b.date)
+	})
+
+	resp := make([]Income, 0, len(dated))
+
+	for _, d := range dated {
+		if first := dated[0].date.Year(); d.date.Year() != first {
+			return nil, fmt.Errorf("%d and %d: %w", first, d.date.Year(), ErrDifferentYears)
+		}
+
+		resp = append(resp, d.Income)
+	}
+
+	return resp, nil
+}
+
+// calcIncome converts income to GEL and calculates its taxes considering income received before it.
+func (s service) calcIncome(
+	ctx context.Context,
+	p Income,
+	tt taxes.TaxType,
+	yearIncome models.Money,
+	rounding taxes.Rounding,
+) (IncomeResponse, taxes.Response, error) {
+	r := ConvertRequest{
+		DateRequest: DateRequest{
+			Year:  p.Year,
+			Month: p.Month,
+			Day:   p.Day,
+		},
+		CurrencyFrom: p.Currency,
+		CurrencyTo:   currencies.GEL,
+		Amount:       p.Amount,
+	}
+
+	convertResp, err := s.Convert(ctx, r)
+	if err != nil {
+		return IncomeResponse{}, taxes.Response{}, fmt.Errorf("failed to convert income: %w", err)
+	}
+
+	tax, err := taxes.Calc(taxes.Income{
+		Amount:     convertResp.Converted,
+		YearIncome: yearIncome,
+		Date:       convertResp.Date,
+		Category:   p.Category,
+		Rounding:   rounding,
+	}, tt)
+	if err != nil {
+		return IncomeResponse{}, taxes.Response{}, fmt.Errorf("failed to calculate taxes: %w", err)
+	}
+
+	return IncomeResponse{
+		ConvertResponse: *convertResp,
+		TaxRate:         tax.Rate,
+		Tax:             tax.Money,
+		Pension:         tax.Pension,
+	}, tax, nil
+}
+
+// calcTotals accumulates results of Calculate. Sums fail when income is not in GEL.
+type calcTotals struct {
+	rates      AppliedRates
+	yearIncome models.Money
+	income     models.Money
+	tax        models.Money
+	pension    taxes.Pension
+	parts      []taxes.Part
+	crossings  []ThresholdCrossing
+	incomes    []IncomeResponse
+}
+
+func newCalcTotals(tt taxes.TaxType, yearIncome models.Money, n int) *calcTotals {
+	return &calcTotals{
+		rates:      AppliedRates{Type: tt},
+		yearIncome: yearIncome,
+		income:     models.NewMoney(decimal.Zero, currencies.GEL),
+		tax:        models.NewMoney(decimal.Zero, currencies.GEL),
+		incomes:    make([]IncomeResponse, 0, n),
+	}
+}
+
+// add adds income and its tax to totals.
+func (t *calcTotals) add(inc IncomeResponse, tax taxes.Response) error {
+	var err error
+
+	if t.yearIncome, err = t.yearIncome.Add(inc.Converted); err != nil {
+		return err
+	}
+
+	if t.income, err = t.income.Add(inc.Converted); err != nil {
+		return err
+	}
+
+	if t.tax, err = t.tax.Add(tax.Money); err != nil {
+		return err
+	}
+
+	if t.parts, err = mergeTaxParts(t.parts, tax.Parts); err != nil {
+		return err
+	}
+
+	inc.YearToDate = t.yearIncome
 
-		convertResp, err := s.Convert(ctx, r)
+	t.rates.add(inc.TaxRate)
+	t.incomes = append(t.incomes, inc)
+	t.pension = t.pension.Add(tax.Pension)
+
+	if tax.ThresholdExceeded() {
+		t.crossings = append(t.crossings, ThresholdCrossing{
+			Number: len(t.incomes),
+			Date:   inc.Date,
+			Parts:  tax.Parts,
+		})
+	}
+
+	return nil
+}
+
+// roundTotalTax returns total tax rounded according to policy.
+// Taxes of incomes are already rounded by RoundPerIncome policy, so their sum is returned as is.
+func roundTotalTax(totals *calcTotals, rounding taxes.Rounding) (models.Money, error) {
+	switch rounding.Policy {
+	case taxes.RoundAtEnd:
+		return totals.tax.RoundWith(rounding.Mode), nil
+	case taxes.RoundPerPeriod:
+		decls, err := groupByPeriod(totals.incomes)
 		if err != nil {
-			return nil, fmt.Errorf("failed to convert income: %w", err)
+			return models.Money{}, err
 		}
 
-		incomes = append(incomes, ConvertResponse{
-			Date:      convertResp.Date,
-			Amount:    convertResp.Amount,
-			Converted: convertResp.Converted,
-			Rate:      convertResp.Rate,
+		tax := models.NewMoney(decimal.Zero, currencies.GEL)
+
+		for _, d := range decls {
+			if tax, err = tax.Add(d.Tax.RoundWith(rounding.Mode)); err != nil {
+				return models.Money{}, err
+			}
+		}
+
+		return tax, nil
+	default:
+		return totals.tax, nil
+	}
+}
+
+// mergeTaxParts adds parts to the totals, grouping them by rate.
+func mergeTaxParts(totals, parts []taxes.Part) ([]taxes.Part, error) {
+	for _, p := range parts {
+		idx := slices.IndexFunc(totals, func(t taxes.Part) bool {
+			return t.Rate == p.Rate && t.OverThreshold == p.OverThreshold
 		})
 
-		tax, err := taxes.Calc(convertResp.Converted, tt)
+		if idx == -1 {
+			totals = append(totals, p)
+
+			continue
+		}
+
+		base, err := totals[idx].Base.Add(p.Base)
 		if err != nil {
-			return nil, fmt.Errorf("failed to calculate taxes: %w", err)
+			return nil, err
 		}
 
-		yi = moneyutils.Add(yi, convertResp.Converted.Amount)
-		inc = moneyutils.Add(inc, convertResp.Converted.Amount)
-		txs = moneyutils.Add(txs, tax.Money.Amount)
+		tax, err := totals[idx].Tax.Add(p.Tax)
+		if err != nil {
+			return nil, err
+		}
+
+		totals[idx].Base = base
+		totals[idx].Tax = tax
 	}
 
-	return &CalculateResponse{
-		TaxRate:              tr,
-		YearIncome:           models.NewMoney(yi, currencies.GEL),
-		Incomes:              incomes,
-		TotalIncomeConverted: models.NewMoney(inc, currencies.GEL),
-		Tax:                  models.NewMoney(txs, currencies.GEL),
-	}, nil
+	return totals, nil
 }
 
 type convertParams struct {
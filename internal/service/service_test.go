@@ -2,11 +2,14 @@
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
 	"time"
 
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/obalunenko/georgia-tax-calculator/internal/converter"
 	"github.com/obalunenko/georgia-tax-calculator/internal/models"
@@ -14,15 +17,19 @@
 	"github.com/obalunenko/georgia-tax-calculator/pkg/nbggovge/currencies"
 )
 
+func money(amount, currency string) models.Money {
+	return models.NewMoney(decimal.RequireFromString(amount), currency)
+}
+
 type mockConverter struct{}
 
-func (m mockConverter) Convert(_ context.Context, money models.Money, toCurrency string, _ time.Time) (converter.Response, error) {
+func (m mockConverter) Convert(_ context.Context, amount models.Money, toCurrency string, _ time.Time) (converter.Response, error) {
 	return converter.Response{
 		Money: models.Money{
-			Amount:   money.Amount,
+			Amount:   amount.Amount,
 			Currency: toCurrency,
 		},
-		Rate: 1,
+		Rate: decimal.NewFromInt(1),
 	}, nil
 }
 
@@ -70,19 +77,62 @@ type args struct {
 				},
 			},
 			want: &ConvertResponse{
-				Date: time.Date(2022, time.December, 8, 0, 0, 0, 0, time.UTC),
-				Amount: models.Money{
-					Amount:   568,
-					Currency: currencies.AED,
-				},
-				Converted: models.Money{
-					Amount:   568,
-					Currency: currencies.EUR,
+				Date:      time.Date(2022, time.December, 8, 0, 0, 0, 0, time.UTC),
+				Amount:    money("568", currencies.AED),
+				Converted: money("568", currencies.EUR),
+				Rate:      money("1", ""),
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "amount with thousands separator and currency symbol",
+			fields: fields{
+				c: mockConverter{},
+			},
+			args: args{
+				ctx: ctx,
+				p: ConvertRequest{
+					DateRequest: DateRequest{
+						Year:  "2022",
+						Month: "December",
+						Day:   "08",
+					},
+					CurrencyFrom: currencies.USD,
+					CurrencyTo:   currencies.EUR,
+					Amount:       "$1,250.50",
 				},
-				Rate: models.NewMoney(1, ""),
+			},
+			want: &ConvertResponse{
+				Date:      time.Date(2022, time.December, 8, 0, 0, 0, 0, time.UTC),
+				Amount:    money("1250.5", currencies.USD),
+				Converted: money("1250.5", currencies.EUR),
+				Rate:      money("1", ""),
 			},
 			wantErr: assert.NoError,
 		},
+		{
+			name: "amount in other currency",
+			fields: fields{
+				c: mockConverter{},
+			},
+			args: args{
+				ctx: ctx,
+				p: ConvertRequest{
+					DateRequest: DateRequest{
+						Year:  "2022",
+						Month: "December",
+						Day:   "08",
+					},
+					CurrencyFrom: currencies.USD,
+					CurrencyTo:   currencies.EUR,
+					Amount:       "€99",
+				},
+			},
+			want: nil,
+			wantErr: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, models.ErrCurrencyMismatch)
+			},
+		},
 		{
 			name: "incorrect request",
 			fields: fields{
@@ -187,36 +237,47 @@ type args struct {
 				},
 			},
 			want: &CalculateResponse{
-				TaxRate: taxes.TaxRate{
-					Type: taxes.TaxTypeEmployment,
-					Rate: 0.2,
-				},
-				YearIncome: models.Money{
-					Amount:   1067.99,
-					Currency: currencies.GEL,
+				TaxRates: AppliedRates{
+					Type:  taxes.TaxTypeEmployment,
+					Rates: []taxes.TaxRate{{Type: taxes.TaxTypeEmployment, Rate: 0.2}},
 				},
-				Incomes: []ConvertResponse{
+				YearIncome: money("1067.99", currencies.GEL),
+				Incomes: []IncomeResponse{
 					{
-						Date: time.Date(2022, time.December, 8, 0, 0, 0, 0, time.UTC),
-						Amount: models.Money{
-							Amount:   1000,
-							Currency: currencies.EUR,
+						ConvertResponse: ConvertResponse{
+							Date:      time.Date(2022, time.December, 8, 0, 0, 0, 0, time.UTC),
+							Amount:    money("1000", currencies.EUR),
+							Converted: money("1000", currencies.GEL),
+							Rate:      money("1", ""),
 						},
-						Converted: models.Money{
-							Amount:   1000,
-							Currency: currencies.GEL,
+						TaxRate: taxes.TaxRate{
+							Type: taxes.TaxTypeEmployment,
+							Rate: 0.2,
+						},
+						Tax:        money("196", currencies.GEL),
+						YearToDate: money("1067.99", currencies.GEL),
+						Pension: taxes.Pension{
+							Employee: money("20", currencies.GEL),
+							Employer: money("20", currencies.GEL),
+							State:    money("20", currencies.GEL),
 						},
-						Rate: models.NewMoney(1, ""),
 					},
 				},
-				TotalIncomeConverted: models.Money{
-					Amount:   1000,
-					Currency: currencies.GEL,
+				TotalIncomeConverted: money("1000", currencies.GEL),
+				Tax:                  money("196", currencies.GEL),
+				Pension: taxes.Pension{
+					Employee: money("20", currencies.GEL),
+					Employer: money("20", currencies.GEL),
+					State:    money("20", currencies.GEL),
 				},
-				Tax: models.Money{
-					Amount:   200,
-					Currency: currencies.GEL,
+				TaxParts: []taxes.Part{
+					{
+						Base: money("980", currencies.GEL),
+						Rate: 0.2,
+						Tax:  money("196", currencies.GEL),
+					},
 				},
+				Rounding: taxes.DefaultRounding,
 			},
 			wantErr: assert.NoError,
 		},
@@ -231,8 +292,8 @@ type args struct {
 					Income: []Income{
 						{
 							DateRequest: DateRequest{
-								Year:  "2022",
-								Month: "December",
+								Year:  "2023",
+								Month: "January",
 								Day:   "08",
 							},
 							Currency: currencies.EUR,
@@ -253,48 +314,227 @@ type args struct {
 				},
 			},
 			want: &CalculateResponse{
-				TaxRate: taxes.TaxRate{
-					Type: taxes.TaxTypeEmployment,
-					Rate: 0.2,
-				},
-				YearIncome: models.Money{
-					Amount:   1267.99,
-					Currency: currencies.GEL,
+				TaxRates: AppliedRates{
+					Type:  taxes.TaxTypeEmployment,
+					Rates: []taxes.TaxRate{{Type: taxes.TaxTypeEmployment, Rate: 0.2}},
 				},
-				Incomes: []ConvertResponse{
+				YearIncome: money("1267.99", currencies.GEL),
+				Incomes: []IncomeResponse{
 					{
-						Date: time.Date(2022, time.December, 8, 0, 0, 0, 0, time.UTC),
-						Amount: models.Money{
-							Amount:   1000,
-							Currency: currencies.EUR,
+						ConvertResponse: ConvertResponse{
+							Date:      time.Date(2023, time.January, 8, 0, 0, 0, 0, time.UTC),
+							Amount:    money("1000", currencies.EUR),
+							Converted: money("1000", currencies.GEL),
+							Rate:      money("1", ""),
 						},
-						Converted: models.Money{
-							Amount:   1000,
-							Currency: currencies.GEL,
+						TaxRate: taxes.TaxRate{
+							Type: taxes.TaxTypeEmployment,
+							Rate: 0.2,
+						},
+						Tax:        money("196", currencies.GEL),
+						YearToDate: money("1067.99", currencies.GEL),
+						Pension: taxes.Pension{
+							Employee: money("20", currencies.GEL),
+							Employer: money("20", currencies.GEL),
+							State:    money("20", currencies.GEL),
+						},
+					},
+					{
+						ConvertResponse: ConvertResponse{
+							Date:      time.Date(2023, time.June, 8, 0, 0, 0, 0, time.UTC),
+							Amount:    money("200", currencies.USD),
+							Converted: money("200", currencies.GEL),
+							Rate:      money("1", ""),
+						},
+						TaxRate: taxes.TaxRate{
+							Type: taxes.TaxTypeEmployment,
+							Rate: 0.2,
+						},
+						Tax:        money("39.2", currencies.GEL),
+						YearToDate: money("1267.99", currencies.GEL),
+						Pension: taxes.Pension{
+							Employee: money("4", currencies.GEL),
+							Employer: money("4", currencies.GEL),
+							State:    money("4", currencies.GEL),
 						},
-						Rate: models.NewMoney(1, ""),
 					},
+				},
+				TotalIncomeConverted: money("1200", currencies.GEL),
+				Tax:                  money("235.2", currencies.GEL),
+				Pension: taxes.Pension{
+					Employee: money("24", currencies.GEL),
+					Employer: money("24", currencies.GEL),
+					State:    money("24", currencies.GEL),
+				},
+				TaxParts: []taxes.Part{
 					{
-						Date: time.Date(2023, time.June, 8, 0, 0, 0, 0, time.UTC),
-						Amount: models.Money{
-							Amount:   200,
+						Base: money("1176", currencies.GEL),
+						Rate: 0.2,
+						Tax:  money("235.2", currencies.GEL),
+					},
+				},
+				Rounding: taxes.DefaultRounding,
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "small business crosses annual threshold",
+			fields: fields{
+				c: mockConverter{},
+			},
+			args: args{
+				ctx: ctx,
+				p: CalculateRequest{
+					Income: []Income{
+						{
+							DateRequest: DateRequest{
+								Year:  "2023",
+								Month: "May",
+								Day:   "08",
+							},
 							Currency: currencies.USD,
+							Amount:   "10000",
 						},
-						Converted: models.Money{
-							Amount:   200,
-							Currency: currencies.GEL,
+						{
+							DateRequest: DateRequest{
+								Year:  "2023",
+								Month: "June",
+								Day:   "08",
+							},
+							Currency: currencies.USD,
+							Amount:   "20000",
+						},
+						{
+							DateRequest: DateRequest{
+								Year:  "2023",
+								Month: "July",
+								Day:   "10",
+							},
+							Currency: currencies.USD,
+							Amount:   "1000",
+						},
+					},
+					TaxType:    taxes.TaxTypeSmallBusiness.String(),
+					YearIncome: "480000",
+				},
+			},
+			want: &CalculateResponse{
+				TaxRates: AppliedRates{
+					Type:  taxes.TaxTypeSmallBusiness,
+					Rates: []taxes.TaxRate{{Type: taxes.TaxTypeSmallBusiness, Rate: 0.01}},
+				},
+				YearIncome: money("511000", currencies.GEL),
+				Incomes: []IncomeResponse{
+					{
+						ConvertResponse: ConvertResponse{
+							Date:      time.Date(2023, time.May, 8, 0, 0, 0, 0, time.UTC),
+							Amount:    money("10000", currencies.USD),
+							Converted: money("10000", currencies.GEL),
+							Rate:      money("1", ""),
+						},
+						TaxRate: taxes.TaxRate{
+							Type: taxes.TaxTypeSmallBusiness,
+							Rate: 0.01,
+						},
+						Tax:        money("100", currencies.GEL),
+						YearToDate: money("490000", currencies.GEL),
+						Pension: taxes.Pension{
+							Employee: money("200", currencies.GEL),
+							Employer: money("200", currencies.GEL),
+							State:    money("0", currencies.GEL),
+						},
+					},
+					{
+						ConvertResponse: ConvertResponse{
+							Date:      time.Date(2023, time.June, 8, 0, 0, 0, 0, time.UTC),
+							Amount:    money("20000", currencies.USD),
+							Converted: money("20000", currencies.GEL),
+							Rate:      money("1", ""),
+						},
+						TaxRate: taxes.TaxRate{
+							Type: taxes.TaxTypeSmallBusiness,
+							Rate: 0.01,
+						},
+						Tax:        money("400", currencies.GEL),
+						YearToDate: money("510000", currencies.GEL),
+						Pension: taxes.Pension{
+							Employee: money("400", currencies.GEL),
+							Employer: money("400", currencies.GEL),
+							State:    money("0", currencies.GEL),
+						},
+					},
+					{
+						ConvertResponse: ConvertResponse{
+							Date:      time.Date(2023, time.July, 10, 0, 0, 0, 0, time.UTC),
+							Amount:    money("1000", currencies.USD),
+							Converted: money("1000", currencies.GEL),
+							Rate:      money("1", ""),
+						},
+						TaxRate: taxes.TaxRate{
+							Type: taxes.TaxTypeSmallBusiness,
+							Rate: 0.01,
+						},
+						Tax:        money("30", currencies.GEL),
+						YearToDate: money("511000", currencies.GEL),
+						Pension: taxes.Pension{
+							Employee: money("20", currencies.GEL),
+							Employer: money("20", currencies.GEL),
+							State:    money("0", currencies.GEL),
 						},
-						Rate: models.NewMoney(1, ""),
 					},
 				},
-				TotalIncomeConverted: models.Money{
-					Amount:   1200,
-					Currency: currencies.GEL,
+				TotalIncomeConverted: money("31000", currencies.GEL),
+				Tax:                  money("530", currencies.GEL),
+				Pension: taxes.Pension{
+					Employee: money("620", currencies.GEL),
+					Employer: money("620", currencies.GEL),
+					State:    money("0", currencies.GEL),
 				},
-				Tax: models.Money{
-					Amount:   240,
-					Currency: currencies.GEL,
+				TaxParts: []taxes.Part{
+					{
+						Base: money("20000", currencies.GEL),
+						Rate: 0.01,
+						Tax:  money("200", currencies.GEL),
+					},
+					{
+						Base:          money("11000", currencies.GEL),
+						Rate:          0.03,
+						Tax:           money("330", currencies.GEL),
+						OverThreshold: true,
+					},
+				},
+				ThresholdCrossings: []ThresholdCrossing{
+					{
+						Number: 2,
+						Date:   time.Date(2023, time.June, 8, 0, 0, 0, 0, time.UTC),
+						Parts: []taxes.Part{
+							{
+								Base: money("10000", currencies.GEL),
+								Rate: 0.01,
+								Tax:  money("100", currencies.GEL),
+							},
+							{
+								Base:          money("10000", currencies.GEL),
+								Rate:          0.03,
+								Tax:           money("300", currencies.GEL),
+								OverThreshold: true,
+							},
+						},
+					},
+					{
+						Number: 3,
+						Date:   time.Date(2023, time.July, 10, 0, 0, 0, 0, time.UTC),
+						Parts: []taxes.Part{
+							{
+								Base:          money("1000", currencies.GEL),
+								Rate:          0.03,
+								Tax:           money("30", currencies.GEL),
+								OverThreshold: true,
+							},
+						},
+					},
 				},
+				Rounding: taxes.DefaultRounding,
 			},
 			wantErr: assert.NoError,
 		},
@@ -379,36 +619,29 @@ func TestCalculateResponse_String(t *testing.T) {
 		{
 			name: "one income",
 			fields: CalculateResponse{
-				TaxRate: taxes.TaxRate{
-					Type: taxes.TaxTypeEmployment,
-					Rate: 0.2,
+				TaxRates: AppliedRates{
+					Type:  taxes.TaxTypeEmployment,
+					Rates: []taxes.TaxRate{{Type: taxes.TaxTypeEmployment, Rate: 0.2}},
 				},
-				YearIncome: models.Money{
-					Amount:   1267.99,
-					Currency: currencies.GEL,
-				},
-				Incomes: []ConvertResponse{
+				YearIncome: money("1267.99", currencies.GEL),
+				Incomes: []IncomeResponse{
 					{
-						Date: time.Date(2022, time.December, 8, 0, 0, 0, 0, time.UTC),
-						Amount: models.Money{
-							Amount:   568.99,
-							Currency: currencies.AED,
+						ConvertResponse: ConvertResponse{
+							Date:      time.Date(2022, time.December, 8, 0, 0, 0, 0, time.UTC),
+							Amount:    money("568.99", currencies.AED),
+							Converted: money("789.99", currencies.GEL),
+							Rate:      money("1.39", ""),
 						},
-						Converted: models.Money{
-							Amount:   789.99,
-							Currency: currencies.GEL,
+						TaxRate: taxes.TaxRate{
+							Type: taxes.TaxTypeEmployment,
+							Rate: 0.2,
 						},
-						Rate: models.NewMoney(1.39, ""),
+						Tax:        money("157.99", currencies.GEL),
+						YearToDate: money("1267.99", currencies.GEL),
 					},
 				},
-				TotalIncomeConverted: models.Money{
-					Amount:   789.99,
-					Currency: currencies.GEL,
-				},
-				Tax: models.Money{
-					Amount:   157.99,
-					Currency: currencies.GEL,
-				},
+				TotalIncomeConverted: money("789.99", currencies.GEL),
+				Tax:                  money("157.99", currencies.GEL),
 			},
 			want: "Tax Rate: Employment 20 %\n" +
 				"Year Income: 1267.99 GEL\n" +
@@ -418,9 +651,153 @@ func TestCalculateResponse_String(t *testing.T) {
 				"\t\tAmount: 568.99 AED\n" +
 				"\t\tConverted: 789.99 GEL\n" +
 				"\t\tRate: 1.39\n" +
+				"\t\tTax Rate: Employment 20 %\n" +
+				"\t\tTax: 157.99 GEL\n" +
+				"\t\tYear To Date: 1267.99 GEL\n" +
 				"Total Income Converted: 789.99 GEL\n" +
 				"Taxes: 157.99 GEL",
 		},
+		{
+			name: "threshold exceeded",
+			fields: CalculateResponse{
+				TaxRates: AppliedRates{
+					Type:  taxes.TaxTypeSmallBusiness,
+					Rates: []taxes.TaxRate{{Type: taxes.TaxTypeSmallBusiness, Rate: 0.01}},
+				},
+				YearIncome: money("501000", currencies.GEL),
+				Incomes: []IncomeResponse{
+					{
+						ConvertResponse: ConvertResponse{
+							Date:      time.Date(2023, time.June, 8, 0, 0, 0, 0, time.UTC),
+							Amount:    money("2000", currencies.GEL),
+							Converted: money("2000", currencies.GEL),
+							Rate:      money("1", ""),
+						},
+						TaxRate: taxes.TaxRate{
+							Type: taxes.TaxTypeSmallBusiness,
+							Rate: 0.01,
+						},
+						Tax:        money("40", currencies.GEL),
+						YearToDate: money("501000", currencies.GEL),
+					},
+				},
+				TotalIncomeConverted: money("2000", currencies.GEL),
+				Tax:                  money("40", currencies.GEL),
+				TaxParts: []taxes.Part{
+					{
+						Base: money("1000", currencies.GEL),
+						Rate: 0.01,
+						Tax:  money("10", currencies.GEL),
+					},
+					{
+						Base:          money("1000", currencies.GEL),
+						Rate:          0.03,
+						Tax:           money("30", currencies.GEL),
+						OverThreshold: true,
+					},
+				},
+				ThresholdCrossings: []ThresholdCrossing{
+					{
+						Number: 1,
+						Date:   time.Date(2023, time.June, 8, 0, 0, 0, 0, time.UTC),
+						Parts: []taxes.Part{
+							{
+								Base: money("1000", currencies.GEL),
+								Rate: 0.01,
+								Tax:  money("10", currencies.GEL),
+							},
+							{
+								Base:          money("1000", currencies.GEL),
+								Rate:          0.03,
+								Tax:           money("30", currencies.GEL),
+								OverThreshold: true,
+							},
+						},
+					},
+				},
+			},
+			want: "Tax Rate: Small Business 1 %\n" +
+				"Year Income: 501000.00 GEL\n" +
+				"Incomes:\n" +
+				"\t- 1:\n" +
+				"\t\tDate: 2023-06-08\n" +
+				"\t\tAmount: 2000.00 GEL\n" +
+				"\t\tConverted: 2000.00 GEL\n" +
+				"\t\tRate: 1\n" +
+				"\t\tTax Rate: Small Business 1 %\n" +
+				"\t\tTax: 40.00 GEL\n" +
+				"\t\tYear To Date: 501000.00 GEL\n" +
+				"Total Income Converted: 2000.00 GEL\n" +
+				"Taxed at rates:\n" +
+				"\t- 1000.00 GEL at 1 % = 10.00 GEL\n" +
+				"\t- 1000.00 GEL at 3 % = 30.00 GEL\n" +
+				"Annual threshold exceeded:\n" +
+				"\t- 1 (2023-06-08):\n" +
+				"\t\t1000.00 GEL at 1 % = 10.00 GEL\n" +
+				"\t\t1000.00 GEL at 3 % = 30.00 GEL\n" +
+				"Taxes: 40.00 GEL",
+		},
+		{
+			name: "pension contributions",
+			fields: CalculateResponse{
+				TaxRates: AppliedRates{
+					Type:  taxes.TaxTypeEmployment,
+					Rates: []taxes.TaxRate{{Type: taxes.TaxTypeEmployment, Rate: 0.2}},
+				},
+				YearIncome: money("1000", currencies.GEL),
+				Incomes: []IncomeResponse{
+					{
+						ConvertResponse: ConvertResponse{
+							Date:      time.Date(2023, time.June, 8, 0, 0, 0, 0, time.UTC),
+							Amount:    money("1000", currencies.GEL),
+							Converted: money("1000", currencies.GEL),
+							Rate:      money("1", ""),
+						},
+						TaxRate: taxes.TaxRate{
+							Type: taxes.TaxTypeEmployment,
+							Rate: 0.2,
+						},
+						Tax:        money("196", currencies.GEL),
+						YearToDate: money("1000", currencies.GEL),
+						Pension: taxes.Pension{
+							Employee: money("20", currencies.GEL),
+							Employer: money("20", currencies.GEL),
+							State:    money("20", currencies.GEL),
+						},
+					},
+				},
+				TotalIncomeConverted: money("1000", currencies.GEL),
+				Tax:                  money("196", currencies.GEL),
+				Pension: taxes.Pension{
+					Employee: money("20", currencies.GEL),
+					Employer: money("20", currencies.GEL),
+					State:    money("20", currencies.GEL),
+				},
+			},
+			want: "Tax Rate: Employment 20 %\n" +
+				"Year Income: 1000.00 GEL\n" +
+				"Incomes:\n" +
+				"\t- 1:\n" +
+				"\t\tDate: 2023-06-08\n" +
+				"\t\tAmount: 1000.00 GEL\n" +
+				"\t\tConverted: 1000.00 GEL\n" +
+				"\t\tRate: 1\n" +
+				"\t\tTax Rate: Employment 20 %\n" +
+				"\t\tTax: 196.00 GEL\n" +
+				"\t\tYear To Date: 1000.00 GEL\n" +
+				"\t\tPension Contributions:\n" +
+				"\t\t\tEmployee: 20.00 GEL\n" +
+				"\t\t\tEmployer: 20.00 GEL\n" +
+				"\t\t\tState: 20.00 GEL\n" +
+				"\t\t\tTotal: 60.00 GEL\n" +
+				"Total Income Converted: 1000.00 GEL\n" +
+				"Pension Contributions:\n" +
+				"\tEmployee: 20.00 GEL\n" +
+				"\tEmployer: 20.00 GEL\n" +
+				"\tState: 20.00 GEL\n" +
+				"\tTotal: 60.00 GEL\n" +
+				"Taxes: 196.00 GEL",
+		},
 	}
 
 	for _, tt := range tests {
@@ -446,16 +823,10 @@ type fields struct {
 		{
 			name: "",
 			fields: fields{
-				Date: time.Date(2022, time.December, 8, 0, 0, 0, 0, time.UTC),
-				Amount: models.Money{
-					Amount:   568.99,
-					Currency: currencies.AED,
-				},
-				Converted: models.Money{
-					Amount:   789.99,
-					Currency: currencies.EUR,
-				},
-				Rate: models.NewMoney(1.39, ""),
+				Date:      time.Date(2022, time.December, 8, 0, 0, 0, 0, time.UTC),
+				Amount:    money("568.99", currencies.AED),
+				Converted: money("789.99", currencies.EUR),
+				Rate:      money("1.39", ""),
 			},
 			want: "Date: 2022-12-08\n" +
 				"Amount: 568.99 AED\n" +
@@ -477,3 +848,378 @@ type fields struct {
 		})
 	}
 }
+
+// mockConverterPrevious returns rate published two days before requested date.
+type mockConverterPrevious struct{}
+
+func (m mockConverterPrevious) Convert(
+	_ context.Context,
+	amount models.Money,
+	toCurrency string,
+	date time.Time,
+) (converter.Response, error) {
+	return converter.Response{
+		Money: models.Money{
+			Amount:   amount.Amount,
+			Currency: toCurrency,
+		},
+		Rate:     decimal.NewFromInt(1),
+		RateDate: date.AddDate(0, 0, -2),
+	}, nil
+}
+
+func Test_service_Convert_RateDate(t *testing.T) {
+	s := service{c: mockConverterPrevious{}}
+
+	got, err := s.Convert(context.Background(), ConvertRequest{
+		DateRequest: DateRequest{
+			Year:  "2023",
+			Month: "September",
+			Day:   "10",
+		},
+		CurrencyFrom: currencies.USD,
+		CurrencyTo:   currencies.GEL,
+		Amount:       "100",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, time.Date(2023, time.September, 10, 0, 0, 0, 0, time.UTC), got.Date)
+	assert.Equal(t, time.Date(2023, time.September, 8, 0, 0, 0, 0, time.UTC), got.RateDate)
+	assert.Equal(t, "Date: 2023-09-10\n"+
+		"Amount: 100.00 USD\n"+
+		"Converted: 100.00 GEL\n"+
+		"Rate: 1\n"+
+		"Rate Date: 2023-09-08", got.String())
+
+	calc, err := s.Calculate(context.Background(), CalculateRequest{
+		Income: []Income{
+			{
+				DateRequest: NewDateRequest(got.Date),
+				Currency:    currencies.USD,
+				Amount:      "100",
+			},
+		},
+		TaxType:    taxes.TaxTypeSmallBusiness.String(),
+		YearIncome: "0",
+	})
+	require.NoError(t, err)
+	require.Len(t, calc.Incomes, 1)
+
+	assert.Equal(t, got.RateDate, calc.Incomes[0].RateDate)
+}
+
+func Test_service_Calculate_ExactSum(t *testing.T) {
+	date := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		amount     string
+		count      int
+		yearIncome string
+		wantTotal  string
+		wantYear   string
+	}{
+		{
+			name:       "tenths",
+			amount:     "0.1",
+			count:      10_000,
+			yearIncome: "0",
+			wantTotal:  "1000",
+			wantYear:   "1000",
+		},
+		{
+			name:       "tetri",
+			amount:     "0.07",
+			count:      10_000,
+			yearIncome: "0.01",
+			wantTotal:  "700",
+			wantYear:   "700.01",
+		},
+		{
+			name:       "above threshold",
+			amount:     "1234.56",
+			count:      5_000,
+			yearIncome: "0.03",
+			wantTotal:  "6172800",
+			wantYear:   "6172800.03",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := service{c: mockConverter{}}
+
+			incomes := make([]Income, tt.count)
+			for i := range incomes {
+				incomes[i] = Income{
+					DateRequest: NewDateRequest(date),
+					Currency:    currencies.GEL,
+					Amount:      tt.amount,
+				}
+			}
+
+			got, err := s.Calculate(context.Background(), CalculateRequest{
+				Income:     incomes,
+				TaxType:    taxes.TaxTypeSmallBusiness.String(),
+				YearIncome: tt.yearIncome,
+			})
+			require.NoError(t, err)
+
+			assert.Equal(t, money(tt.wantTotal, currencies.GEL), got.TotalIncomeConverted)
+			assert.Equal(t, money(tt.wantYear, currencies.GEL), got.YearIncome)
+			assert.Equal(t, money(tt.wantYear, currencies.GEL), got.Incomes[len(got.Incomes)-1].YearToDate)
+
+			var base, tax decimal.Decimal
+
+			for _, p := range got.TaxParts {
+				base = base.Add(p.Base.Amount)
+				tax = tax.Add(p.Tax.Amount)
+			}
+
+			assert.Equal(t, tt.wantTotal, base.String())
+			assert.Equal(t, got.Tax.Amount.String(), tax.String())
+		})
+	}
+}
+
+// mockConverterNoop returns amount in original currency, ignoring requested currency.
+type mockConverterNoop struct{}
+
+func (m mockConverterNoop) Convert(_ context.Context, amount models.Money, _ string, _ time.Time) (converter.Response, error) {
+	return converter.Response{
+		Money: amount,
+		Rate:  decimal.NewFromInt(1),
+	}, nil
+}
+
+func Test_service_Calculate_CurrencyMismatch(t *testing.T) {
+	s := service{c: mockConverterNoop{}}
+
+	_, err := s.Calculate(context.Background(), CalculateRequest{
+		Income: []Income{
+			{
+				DateRequest: NewDateRequest(time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)),
+				Currency:    currencies.USD,
+				Amount:      "100",
+			},
+		},
+		TaxType:    taxes.TaxTypeSmallBusiness.String(),
+		YearIncome: "0",
+	})
+	require.ErrorIs(t, err, models.ErrCurrencyMismatch)
+}
+
+func Test_service_Calculate_Rounding(t *testing.T) {
+	income := func(month time.Month) Income {
+		return Income{
+			DateRequest: NewDateRequest(time.Date(2024, month, 10, 0, 0, 0, 0, time.UTC)),
+			Currency:    currencies.GEL,
+			Amount:      "12.5",
+		}
+	}
+
+	// Tax of each income is 0.125 GEL: 0.375 GEL in January and 0.125 GEL in February.
+	incomes := []Income{
+		income(time.January),
+		income(time.February),
+		income(time.January),
+		income(time.January),
+	}
+
+	tests := []struct {
+		name     string
+		rounding taxes.Rounding
+		want     string
+	}{
+		{name: "default", want: "0.52"},
+		{name: "per income half-up", rounding: taxes.Rounding{Policy: taxes.RoundPerIncome, Mode: models.RoundingHalfUp}, want: "0.52"},
+		{name: "per income half-even", rounding: taxes.Rounding{Policy: taxes.RoundPerIncome, Mode: models.RoundingHalfEven}, want: "0.48"},
+		{name: "per income truncate", rounding: taxes.Rounding{Policy: taxes.RoundPerIncome, Mode: models.RoundingTruncate}, want: "0.48"},
+		{name: "per period half-up", rounding: taxes.Rounding{Policy: taxes.RoundPerPeriod, Mode: models.RoundingHalfUp}, want: "0.51"},
+		{name: "per period half-even", rounding: taxes.Rounding{Policy: taxes.RoundPerPeriod, Mode: models.RoundingHalfEven}, want: "0.5"},
+		{name: "per period truncate", rounding: taxes.Rounding{Policy: taxes.RoundPerPeriod, Mode: models.RoundingTruncate}, want: "0.49"},
+		{name: "at end truncate", rounding: taxes.Rounding{Policy: taxes.RoundAtEnd, Mode: models.RoundingTruncate}, want: "0.5"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := service{c: mockConverter{}}
+
+			got, err := s.Calculate(context.Background(), CalculateRequest{
+				Income:     incomes,
+				TaxType:    taxes.TaxTypeSmallBusiness.String(),
+				YearIncome: "0",
+				Rounding:   tt.rounding,
+			})
+			require.NoError(t, err)
+
+			assert.Equal(t, money(tt.want, currencies.GEL), got.Tax)
+			assert.Equal(t, tt.rounding.OrDefault(), got.Rounding)
+
+			decls, err := s.Declarations(context.Background(), CalculateRequest{
+				Income:     incomes,
+				TaxType:    taxes.TaxTypeSmallBusiness.String(),
+				YearIncome: "0",
+				Rounding:   tt.rounding,
+			})
+			require.NoError(t, err)
+			require.Len(t, decls.Declarations, 2)
+
+			assert.Equal(t, got.Rounding, decls.Rounding)
+
+			// Taxes of declarations add up to total tax.
+			declared := money("0", currencies.GEL)
+
+			for _, d := range decls.Declarations {
+				declared, err = declared.Add(d.Tax)
+				require.NoError(t, err)
+			}
+
+			assert.Equal(t, got.Tax, declared)
+			assert.Equal(t, got.Tax, decls.Tax)
+		})
+	}
+}
+
+func Test_service_Calculate_RoundingText(t *testing.T) {
+	s := service{c: mockConverter{}}
+
+	got, err := s.Calculate(context.Background(), CalculateRequest{
+		Income: []Income{{
+			DateRequest: NewDateRequest(time.Date(2024, time.January, 10, 0, 0, 0, 0, time.UTC)),
+			Currency:    currencies.GEL,
+			Amount:      "12.5",
+		}},
+		TaxType:    taxes.TaxTypeSmallBusiness.String(),
+		YearIncome: "0",
+		Rounding:   taxes.Rounding{Policy: taxes.RoundAtEnd, Mode: models.RoundingTruncate},
+	})
+	require.NoError(t, err)
+
+	// Tax of income is exact 0.125 GEL, it is shown truncated as total tax is.
+	assert.Equal(t, money("0.125", currencies.GEL), got.Incomes[0].Tax)
+	assert.Contains(t, got.String(), "Tax: 0.12 GEL\n")
+	assert.Contains(t, got.String(), "12.50 GEL at 1 % = 0.12 GEL\n")
+	assert.NotContains(t, got.String(), "0.13 GEL")
+}
+
+// rateChangeRegime taxes incomes at 1% before July 2024 and at 2% since then.
+type rateChangeRegime struct{}
+
+const taxTypeRateChange taxes.TaxType = "Rate Change"
+
+func (rateChangeRegime) Type() taxes.TaxType {
+	return taxTypeRateChange
+}
+
+func (rateChangeRegime) Rate(date time.Time) (taxes.TaxRate, error) {
+	if date.Before(time.Date(2024, time.July, 1, 0, 0, 0, 0, time.UTC)) {
+		return taxes.TaxRate{Type: taxTypeRateChange, Rate: 0.01}, nil
+	}
+
+	return taxes.TaxRate{Type: taxTypeRateChange, Rate: 0.02}, nil
+}
+
+func (r rateChangeRegime) Calc(income taxes.Income) (taxes.Response, error) {
+	tr, err := r.Rate(income.Date)
+	if err != nil {
+		return taxes.Response{}, err
+	}
+
+	return taxes.Response{
+		Money: income.Amount.Mul(decimal.NewFromFloat(tr.Rate)).Round(),
+		Rate:  tr,
+	}, nil
+}
+
+func Test_service_Calculate_RateChange(t *testing.T) {
+	if err := taxes.Register(rateChangeRegime{}); err != nil && !errors.Is(err, taxes.ErrRegimeAlreadyRegistered) {
+		require.NoError(t, err)
+	}
+
+	income := func(month time.Month) Income {
+		return Income{
+			DateRequest: NewDateRequest(time.Date(2024, month, 10, 0, 0, 0, 0, time.UTC)),
+			Currency:    currencies.GEL,
+			Amount:      "100",
+		}
+	}
+
+	s := service{c: mockConverter{}}
+
+	got, err := s.Calculate(context.Background(), CalculateRequest{
+		Income:     []Income{income(time.June), income(time.July), income(time.August)},
+		TaxType:    taxTypeRateChange.String(),
+		YearIncome: "0",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, AppliedRates{
+		Type: taxTypeRateChange,
+		Rates: []taxes.TaxRate{
+			{Type: taxTypeRateChange, Rate: 0.01},
+			{Type: taxTypeRateChange, Rate: 0.02},
+		},
+	}, got.TaxRates)
+	assert.Equal(t, "Rate Change 1 %, Rate Change 2 %", got.TaxRates.String())
+	assert.Equal(t, taxes.TaxRate{Type: taxTypeRateChange, Rate: 0.01}, got.Incomes[0].TaxRate)
+	assert.Equal(t, money("5", currencies.GEL), got.Tax)
+}
+
+// categoryRegime taxes royalties at 5% and other incomes at 10%.
+type categoryRegime struct{}
+
+const taxTypeCategory taxes.TaxType = "Category"
+
+func (categoryRegime) Type() taxes.TaxType {
+	return taxTypeCategory
+}
+
+func (categoryRegime) Rate(time.Time) (taxes.TaxRate, error) {
+	return taxes.TaxRate{Type: taxTypeCategory, Rate: 0.1}, nil
+}
+
+func (r categoryRegime) Calc(income taxes.Income) (taxes.Response, error) {
+	tr, err := r.Rate(income.Date)
+	if err != nil {
+		return taxes.Response{}, err
+	}
+
+	if income.Category == "royalty" {
+		tr.Rate = 0.05
+	}
+
+	return taxes.Response{
+		Money: income.Amount.Mul(decimal.NewFromFloat(tr.Rate)).Round(),
+		Rate:  tr,
+	}, nil
+}
+
+func Test_service_Calculate_Category(t *testing.T) {
+	if err := taxes.Register(categoryRegime{}); err != nil && !errors.Is(err, taxes.ErrRegimeAlreadyRegistered) {
+		require.NoError(t, err)
+	}
+
+	income := func(category string) Income {
+		return Income{
+			DateRequest: NewDateRequest(time.Date(2024, time.March, 10, 0, 0, 0, 0, time.UTC)),
+			Currency:    currencies.GEL,
+			Amount:      "100",
+			Category:    category,
+		}
+	}
+
+	s := service{c: mockConverter{}}
+
+	got, err := s.Calculate(context.Background(), CalculateRequest{
+		Income:     []Income{income("royalty"), income("")},
+		TaxType:    taxTypeCategory.String(),
+		YearIncome: "0",
+	})
+	require.NoError(t, err)
+	require.Len(t, got.Incomes, 2)
+
+	assert.Equal(t, money("5", currencies.GEL), got.Incomes[0].Tax)
+	assert.Equal(t, money("10", currencies.GEL), got.Incomes[1].Tax)
+	assert.Equal(t, money("15", currencies.GEL), got.Tax)
+}
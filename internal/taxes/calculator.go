@@ -4,157 +4,152 @@
 import (
 	"errors"
 	"fmt"
-	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
 
 	"github.com/obalunenko/georgia-tax-calculator/internal/models"
 	"github.com/obalunenko/georgia-tax-calculator/pkg/moneyutils"
 )
 
 var (
-	// ErrTaxRateNotFound returned when no tax rate found in taxrates.
+	// ErrTaxRateNotFound returned when no tax rate in force found for TaxType.
 	ErrTaxRateNotFound = errors.New("tax rate not found")
 	// ErrTaxTypeNotSupported returned when TaxType has invalid value.
 	ErrTaxTypeNotSupported = errors.New("tax type not supported")
 )
 
-//go:generate stringer --type=TaxType --trimprefix=true --linecomment=true
-
-// TaxType represents tax type for calculation.
-type TaxType uint
+// TaxType represents tax type for calculation. It is a name of registered Regime.
+type TaxType string
 
 const (
-	taxTypeUnknown TaxType = iota
-
 	// TaxTypeIndividualEntrepreneur is Individual Entrepreneur tax type.
-	TaxTypeIndividualEntrepreneur // Individual Entrepreneur
+	TaxTypeIndividualEntrepreneur TaxType = "Individual Entrepreneur"
 	// TaxTypeSmallBusiness is Small Business tax type.
-	TaxTypeSmallBusiness // Small Business
+	TaxTypeSmallBusiness TaxType = "Small Business"
 	// TaxTypeEmployment is Employment tax type.
-	TaxTypeEmployment // Employment
-
-	// taxTypeSentinel should be always last - used as a border of valid values.
-	taxTypeSentinel
+	TaxTypeEmployment TaxType = "Employment"
 )
 
 // ErrInvalidTaxType returned when tax type is invalid.
 var ErrInvalidTaxType = errors.New("invalid tax type")
 
-var stringToTaxType = map[string]TaxType{
-	strings.ToLower(TaxTypeSmallBusiness.String()):          TaxTypeSmallBusiness,
-	strings.ToLower(TaxTypeIndividualEntrepreneur.String()): TaxTypeIndividualEntrepreneur,
-	strings.ToLower(TaxTypeEmployment.String()):             TaxTypeEmployment,
-}
-
-// ParseTaxType parses TaxType from string.
+// ParseTaxType parses TaxType of registered Regime from string.
 func ParseTaxType(raw string) (TaxType, error) {
-	tt, ok := stringToTaxType[strings.TrimSpace(strings.ToLower(raw))]
-	if !ok {
-		return taxTypeUnknown, fmt.Errorf("%s: %w", raw, ErrInvalidTaxType)
+	r, err := Lookup(TaxType(raw))
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", raw, ErrInvalidTaxType)
 	}
 
-	return tt, nil
+	return r.Type(), nil
 }
 
-// Valid checks if value of TaxType is in valid borders.
-func (i TaxType) Valid() bool {
-	return i > taxTypeUnknown && i < taxTypeSentinel
+func (i TaxType) String() string {
+	return string(i)
 }
 
-const (
-	onePercent     = 0.01
-	threePercents  = onePercent * 3
-	twentyPercents = onePercent * 20
-)
-
-var taxrates = map[TaxType]TaxRate{
-	TaxTypeSmallBusiness:          newTaxRate(TaxTypeSmallBusiness, onePercent),
-	TaxTypeIndividualEntrepreneur: newTaxRate(TaxTypeIndividualEntrepreneur, threePercents),
-	TaxTypeEmployment:             newTaxRate(TaxTypeEmployment, twentyPercents),
-}
+// Valid checks if Regime is registered for TaxType.
+func (i TaxType) Valid() bool {
+	_, err := Lookup(i)
 
-// TaxRate represents tuple TaxType - rate.
-type TaxRate struct {
-	Type TaxType
-	Rate float64
+	return err == nil
 }
 
-func (t TaxRate) String() string {
-	const toPercentage float64 = 100
-
-	return fmt.Sprintf("%s %s %%", t.Type.String(), moneyutils.ToString(moneyutils.Multiply(t.Rate, toPercentage)))
+// Income represents income to be taxed.
+type Income struct {
+	// Amount of income.
+	Amount models.Money
+	// YearIncome is an income received from the beginning of a calendar year before this income.
+	YearIncome models.Money
+	// Date of income. Tax rate in force on this date is applied.
+	Date time.Time
+	// Category of income. Regimes may use it to tax kinds of income differently.
+	Category string
+	// Rounding of tax. Zero value means DefaultRounding.
+	Rounding Rounding
 }
 
-func newTaxRate(tt TaxType, rate float64) TaxRate {
-	return TaxRate{
-		Type: tt,
-		Rate: rate,
-	}
+// Response represents result of Calc.
+type Response struct {
+	Money models.Money
+	Rate  TaxRate
+	// Parts holds portions of income taxed at different rates.
+	Parts []Part
+	// Pension holds funded pension contributions for income.
+	Pension Pension
 }
 
-// AllTaxRates returns all supported TaxRate.
-func AllTaxRates() ([]TaxRate, error) {
-	taxes := AllTaxTypes()
-
-	resp := make([]TaxRate, 0, len(taxes))
-
-	for _, tax := range taxes {
-		tr, err := tax.Rate()
-		if err != nil {
-			return nil, err
+// ThresholdExceeded reports whether any part of income was taxed above the annual threshold.
+func (r Response) ThresholdExceeded() bool {
+	for _, p := range r.Parts {
+		if p.OverThreshold {
+			return true
 		}
-
-		resp = append(resp, tr)
 	}
 
-	return resp, nil
+	return false
 }
 
-// AllTaxTypes returns all supported TaxType.
-func AllTaxTypes() []TaxType {
-	taxes := make([]TaxType, 0, len(taxrates))
-
-	for tt := range taxrates {
-		taxes = append(taxes, tt)
-	}
+// Part represents portion of income taxed at a single rate.
+type Part struct {
+	Base models.Money
+	Rate float64
+	Tax  models.Money
+	// OverThreshold is true when portion of income is above the annual threshold of TaxType.
+	OverThreshold bool
+}
 
-	return taxes
+// StringWith returns base, rate and tax of part with tax rounded with mode, see Rounding.
+func (p Part) StringWith(mode models.RoundingMode) string {
+	return fmt.Sprintf("%s at %s = %s", p.Base.String(), formatRate(p.Rate), p.Tax.StringWith(mode))
 }
 
-// Rate convert TaxType to TaxRate.
-func (i TaxType) Rate() (TaxRate, error) {
-	tr, ok := taxrates[i]
-	if !ok {
-		return TaxRate{}, ErrTaxRateNotFound
+// Calc returns sum of tax for income according to Regime registered for TaxType.
+func Calc(income Income, taxType TaxType) (Response, error) {
+	r, err := Lookup(taxType)
+	if err != nil {
+		return Response{}, err
 	}
 
-	return tr, nil
+	return r.Calc(income)
 }
 
-// Response represents result of Calc.
-type Response struct {
-	Money models.Money
-	Rate  TaxRate
-}
+// splitIncome splits income into parts below and above annual threshold of tax rate.
+func splitIncome(income Income, tr TaxRate, th *threshold) []Part {
+	amount := income.Amount.Amount
+	currency := income.Amount.Currency
 
-// Calc returns sum of tax for income according to TaxType.
-func Calc(income models.Money, taxType TaxType) (Response, error) {
-	const roundPlaces int32 = 2
-
-	if !taxType.Valid() {
-		return Response{}, fmt.Errorf("%s: %w", taxType.String(), ErrTaxTypeNotSupported)
+	if th == nil {
+		return []Part{newPart(amount, currency, tr.Rate, false, income.Rounding)}
 	}
 
-	tr, err := taxType.Rate()
-	if err != nil {
-		return Response{}, fmt.Errorf("get tax rate: %w", err)
+	limit := moneyutils.FromFloat(th.limit)
+	before := income.YearIncome.Amount
+	after := moneyutils.Add(before, amount)
+
+	switch {
+	case after.LessThanOrEqual(limit):
+		return []Part{newPart(amount, currency, tr.Rate, false, income.Rounding)}
+	case before.GreaterThanOrEqual(limit):
+		return []Part{newPart(amount, currency, th.rate, true, income.Rounding)}
+	default:
+		below := moneyutils.Sub(limit, before)
+		above := moneyutils.Sub(after, limit)
+
+		return []Part{
+			newPart(below, currency, tr.Rate, false, income.Rounding),
+			newPart(above, currency, th.rate, true, income.Rounding),
+		}
 	}
+}
 
-	sum := moneyutils.Multiply(income.Amount, tr.Rate)
-
-	rounded := moneyutils.Round(sum, roundPlaces)
+func newPart(base decimal.Decimal, currency string, rate float64, overThreshold bool, rounding Rounding) Part {
+	b := models.NewMoney(base, currency)
 
-	return Response{
-		Money: models.NewMoney(rounded, income.Currency),
-		Rate:  tr,
-	}, nil
+	return Part{
+		Base:          b,
+		Rate:          rate,
+		Tax:           rounding.roundIncomeTax(b.Mul(moneyutils.FromFloat(rate))),
+		OverThreshold: overThreshold,
+	}
 }
@@ -2,18 +2,25 @@
 
 import (
 	"testing"
+	"time"
 
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/obalunenko/georgia-tax-calculator/internal/models"
 	"github.com/obalunenko/georgia-tax-calculator/pkg/nbggovge/currencies"
 )
 
+func money(amount, currency string) models.Money {
+	return models.NewMoney(decimal.RequireFromString(amount), currency)
+}
+
 func TestCalc(t *testing.T) {
-	const taxTypeNotExist = TaxType(999)
+	const taxTypeNotExist TaxType = "Not Exist"
 
 	type args struct {
-		income  models.Money
+		income  Income
 		taxType TaxType
 	}
 
@@ -26,52 +33,164 @@ type args struct {
 		{
 			name: "small business",
 			args: args{
-				income:  models.NewMoney(100278.88, currencies.GEL),
+				income: Income{
+					Amount: money("100278.88", currencies.GEL),
+				},
+				taxType: TaxTypeSmallBusiness,
+			},
+			want: Response{
+				Money: money("1002.79", currencies.GEL),
+				Rate: TaxRate{
+					Type: TaxTypeSmallBusiness,
+					Rate: 0.01,
+				},
+				Parts: []Part{
+					{
+						Base: money("100278.88", currencies.GEL),
+						Rate: 0.01,
+						Tax:  money("1002.79", currencies.GEL),
+					},
+				},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "small business - income reaches threshold exactly",
+			args: args{
+				income: Income{
+					Amount:     money("100000", currencies.GEL),
+					YearIncome: money("400000", currencies.GEL),
+				},
+				taxType: TaxTypeSmallBusiness,
+			},
+			want: Response{
+				Money: money("1000", currencies.GEL),
+				Rate: TaxRate{
+					Type: TaxTypeSmallBusiness,
+					Rate: 0.01,
+				},
+				Parts: []Part{
+					{
+						Base: money("100000", currencies.GEL),
+						Rate: 0.01,
+						Tax:  money("1000", currencies.GEL),
+					},
+				},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "small business - income straddles threshold",
+			args: args{
+				income: Income{
+					Amount:     money("100278.88", currencies.GEL),
+					YearIncome: money("450000", currencies.GEL),
+				},
+				taxType: TaxTypeSmallBusiness,
+			},
+			want: Response{
+				Money: money("2008.37", currencies.GEL),
+				Rate: TaxRate{
+					Type: TaxTypeSmallBusiness,
+					Rate: 0.01,
+				},
+				Parts: []Part{
+					{
+						Base: money("50000", currencies.GEL),
+						Rate: 0.01,
+						Tax:  money("500", currencies.GEL),
+					},
+					{
+						Base:          money("50278.88", currencies.GEL),
+						Rate:          0.03,
+						Tax:           money("1508.37", currencies.GEL),
+						OverThreshold: true,
+					},
+				},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "small business - year income already above threshold",
+			args: args{
+				income: Income{
+					Amount:     money("100278.88", currencies.GEL),
+					YearIncome: money("500000.01", currencies.GEL),
+				},
 				taxType: TaxTypeSmallBusiness,
 			},
 			want: Response{
-				Money: models.NewMoney(1002.79, currencies.GEL),
+				Money: money("3008.37", currencies.GEL),
 				Rate: TaxRate{
 					Type: TaxTypeSmallBusiness,
 					Rate: 0.01,
 				},
+				Parts: []Part{
+					{
+						Base:          money("100278.88", currencies.GEL),
+						Rate:          0.03,
+						Tax:           money("3008.37", currencies.GEL),
+						OverThreshold: true,
+					},
+				},
 			},
 			wantErr: assert.NoError,
 		},
 		{
 			name: "Individual Entrepreneur",
 			args: args{
-				income:  models.NewMoney(100278.88, currencies.GEL),
+				income: Income{
+					Amount:     money("100278.88", currencies.GEL),
+					YearIncome: money("600000", currencies.GEL),
+				},
 				taxType: TaxTypeIndividualEntrepreneur,
 			},
 			want: Response{
-				Money: models.NewMoney(3008.37, currencies.GEL),
+				Money: money("3008.37", currencies.GEL),
 				Rate: TaxRate{
 					Type: TaxTypeIndividualEntrepreneur,
 					Rate: 0.03,
 				},
+				Parts: []Part{
+					{
+						Base: money("100278.88", currencies.GEL),
+						Rate: 0.03,
+						Tax:  money("3008.37", currencies.GEL),
+					},
+				},
 			},
 			wantErr: assert.NoError,
 		},
 		{
 			name: "Employment",
 			args: args{
-				income:  models.NewMoney(100278.88, currencies.GEL),
+				income: Income{
+					Amount: money("100278.88", currencies.GEL),
+				},
 				taxType: TaxTypeEmployment,
 			},
 			want: Response{
-				Money: models.NewMoney(20055.78, currencies.GEL),
+				Money: money("20055.78", currencies.GEL),
 				Rate: TaxRate{
 					Type: TaxTypeEmployment,
 					Rate: 0.2,
 				},
+				Parts: []Part{
+					{
+						Base: money("100278.88", currencies.GEL),
+						Rate: 0.2,
+						Tax:  money("20055.78", currencies.GEL),
+					},
+				},
 			},
 			wantErr: assert.NoError,
 		},
 		{
 			name: "Not exist - error",
 			args: args{
-				income:  models.NewMoney(100278.88, currencies.GEL),
+				income: Income{
+					Amount: money("100278.88", currencies.GEL),
+				},
 				taxType: taxTypeNotExist,
 			},
 			want:    Response{},
@@ -91,12 +210,30 @@ type args struct {
 	}
 }
 
+func TestResponse_ThresholdExceeded(t *testing.T) {
+	got, err := Calc(Income{
+		Amount:     money("1000", currencies.GEL),
+		YearIncome: money("499500", currencies.GEL),
+	}, TaxTypeSmallBusiness)
+	require.NoError(t, err)
+
+	assert.True(t, got.ThresholdExceeded())
+
+	got, err = Calc(Income{
+		Amount:     money("1000", currencies.GEL),
+		YearIncome: money("499000", currencies.GEL),
+	}, TaxTypeSmallBusiness)
+	require.NoError(t, err)
+
+	assert.False(t, got.ThresholdExceeded())
+}
+
 func TestAllTaxTypes(t *testing.T) {
 	expected := []TaxType{
-		TaxTypeSmallBusiness, TaxTypeIndividualEntrepreneur, TaxTypeEmployment,
+		TaxTypeEmployment, TaxTypeSmallBusiness, TaxTypeIndividualEntrepreneur,
 	}
 
-	assert.ElementsMatchf(t, expected, AllTaxTypes(), "AllTaxTypes()")
+	assert.Equalf(t, expected, AllTaxTypes(), "AllTaxTypes()")
 }
 
 func TestTaxType_Rate(t *testing.T) {
@@ -126,7 +263,7 @@ func TestTaxType_Rate(t *testing.T) {
 		},
 		{
 			name:    "Not supported - error",
-			i:       TaxType(0),
+			i:       TaxType(""),
 			want:    TaxRate{},
 			wantErr: assert.Error,
 		},
@@ -134,7 +271,7 @@ func TestTaxType_Rate(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := tt.i.Rate()
+			got, err := tt.i.Rate(time.Now())
 			if !tt.wantErr(t, err, "Rate()") {
 				return
 			}
@@ -0,0 +1,171 @@
+package taxes
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/obalunenko/georgia-tax-calculator/internal/models"
+	"github.com/obalunenko/georgia-tax-calculator/pkg/moneyutils"
+)
+
+// pensionMode represents how TaxType participates in the funded pension scheme.
+type pensionMode uint
+
+const (
+	// pensionNone - no pension contributions.
+	pensionNone pensionMode = iota
+	// pensionWithheld - employee share is withheld from income before income tax is charged.
+	pensionWithheld
+	// pensionSelfEmployed - taxpayer pays both employee and employer shares,
+	// tax is charged on the whole income.
+	pensionSelfEmployed
+)
+
+// Pension represents funded pension contributions.
+// For self-employed taxpayers both Employee and Employer shares are paid by the taxpayer.
+type Pension struct {
+	Employee models.Money
+	Employer models.Money
+	State    models.Money
+}
+
+// Total returns sum of all contributions.
+func (p Pension) Total() models.Money {
+	sum := moneyutils.Add(p.Employee.Amount, p.Employer.Amount)
+	sum = moneyutils.Add(sum, p.State.Amount)
+
+	return models.NewMoney(sum, p.currency())
+}
+
+// IsZero reports whether there are no contributions.
+func (p Pension) IsZero() bool {
+	return p.Employee.Amount.IsZero() && p.Employer.Amount.IsZero() && p.State.Amount.IsZero()
+}
+
+// Add returns sum of contributions.
+func (p Pension) Add(other Pension) Pension {
+	currency := p.currency()
+	if currency == "" {
+		currency = other.currency()
+	}
+
+	return Pension{
+		Employee: models.NewMoney(moneyutils.Add(p.Employee.Amount, other.Employee.Amount), currency),
+		Employer: models.NewMoney(moneyutils.Add(p.Employer.Amount, other.Employer.Amount), currency),
+		State:    models.NewMoney(moneyutils.Add(p.State.Amount, other.State.Amount), currency),
+	}
+}
+
+func (p Pension) currency() string {
+	for _, m := range []models.Money{p.Employee, p.Employer, p.State} {
+		if m.Currency != "" {
+			return m.Currency
+		}
+	}
+
+	return ""
+}
+
+// stateBracket represents state contribution rate for annual income up to limit.
+// Zero limit means no upper limit.
+type stateBracket struct {
+	limit float64
+	rate  float64
+}
+
+// pensionScheme represents contribution rates in force during period [from, to).
+// Zero to means the scheme is still in force.
+type pensionScheme struct {
+	from     time.Time
+	to       time.Time
+	employee float64
+	employer float64
+	state    []stateBracket
+}
+
+func (s pensionScheme) inForce(date time.Time) bool {
+	return rateEntry{from: s.from, to: s.to}.inForce(date)
+}
+
+const (
+	twoPercents = onePercent * 2
+
+	stateFullShareLimit    float64 = 24_000
+	statePartialShareLimit float64 = 60_000
+)
+
+// pensionSchemes holds history of funded pension contribution rates.
+var pensionSchemes = []pensionScheme{
+	{
+		from:     time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC),
+		employee: twoPercents,
+		employer: twoPercents,
+		state: []stateBracket{
+			{limit: stateFullShareLimit, rate: twoPercents},
+			{limit: statePartialShareLimit, rate: onePercent},
+		},
+	},
+}
+
+func pensionSchemeAt(date time.Time) (pensionScheme, bool) {
+	for _, s := range pensionSchemes {
+		if s.inForce(date) {
+			return s, true
+		}
+	}
+
+	return pensionScheme{}, false
+}
+
+// calcPension calculates pension contributions for income. Contributions are paid per income,
+// so they are rounded with mode of rounding of income regardless of its policy.
+func calcPension(income Income, mode pensionMode) Pension {
+	if mode == pensionNone {
+		return Pension{}
+	}
+
+	scheme, ok := pensionSchemeAt(income.Date)
+	if !ok {
+		return Pension{}
+	}
+
+	rounding := income.Rounding.OrDefault().Mode
+
+	contribution := func(rate float64) models.Money {
+		return income.Amount.Mul(moneyutils.FromFloat(rate)).RoundWith(rounding)
+	}
+
+	return Pension{
+		Employee: contribution(scheme.employee),
+		Employer: contribution(scheme.employer),
+		State:    models.NewMoney(stateShare(income, scheme.state), income.Amount.Currency).RoundWith(rounding),
+	}
+}
+
+// stateShare calculates state contribution considering income received from the beginning of the year.
+func stateShare(income Income, brackets []stateBracket) decimal.Decimal {
+	var (
+		share decimal.Decimal
+		lower decimal.Decimal
+	)
+
+	before := income.YearIncome.Amount
+	after := moneyutils.Add(before, income.Amount.Amount)
+
+	for _, b := range brackets {
+		upper := after
+		if b.limit != 0 {
+			upper = moneyutils.FromFloat(b.limit)
+		}
+
+		base := moneyutils.Sub(decimal.Min(after, upper), decimal.Max(before, lower))
+		if base.IsPositive() {
+			share = moneyutils.Add(share, moneyutils.Multiply(base, moneyutils.FromFloat(b.rate)))
+		}
+
+		lower = upper
+	}
+
+	return share
+}
@@ -0,0 +1,144 @@
+package taxes
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/obalunenko/georgia-tax-calculator/internal/models"
+	"github.com/obalunenko/georgia-tax-calculator/pkg/nbggovge/currencies"
+)
+
+func TestCalc_Pension(t *testing.T) {
+	type args struct {
+		income  Income
+		taxType TaxType
+	}
+
+	tests := []struct {
+		name        string
+		args        args
+		wantTax     models.Money
+		wantPension Pension
+	}{
+		{
+			name: "employment - employee share deducted before tax",
+			args: args{
+				income: Income{
+					Amount: money("1000", currencies.GEL),
+					Date:   date(2023, time.March, 1),
+				},
+				taxType: TaxTypeEmployment,
+			},
+			wantTax: money("196", currencies.GEL),
+			wantPension: Pension{
+				Employee: money("20", currencies.GEL),
+				Employer: money("20", currencies.GEL),
+				State:    money("20", currencies.GEL),
+			},
+		},
+		{
+			name: "employment - state share crosses both brackets",
+			args: args{
+				income: Income{
+					Amount:     money("40000", currencies.GEL),
+					YearIncome: money("23000", currencies.GEL),
+					Date:       date(2023, time.March, 1),
+				},
+				taxType: TaxTypeEmployment,
+			},
+			wantTax: money("7840", currencies.GEL),
+			wantPension: Pension{
+				Employee: money("800", currencies.GEL),
+				Employer: money("800", currencies.GEL),
+				// 1000 * 2% + 36000 * 1% + 3000 * 0%.
+				State: money("380", currencies.GEL),
+			},
+		},
+		{
+			name: "small business - self-employed, tax on whole income",
+			args: args{
+				income: Income{
+					Amount:     money("1000", currencies.GEL),
+					YearIncome: money("70000", currencies.GEL),
+					Date:       date(2023, time.March, 1),
+				},
+				taxType: TaxTypeSmallBusiness,
+			},
+			wantTax: money("10", currencies.GEL),
+			wantPension: Pension{
+				Employee: money("20", currencies.GEL),
+				Employer: money("20", currencies.GEL),
+				State:    money("0", currencies.GEL),
+			},
+		},
+		{
+			name: "employment - shares rounded with rounding mode",
+			args: args{
+				income: Income{
+					Amount:   money("10.25", currencies.GEL),
+					Date:     date(2023, time.March, 1),
+					Rounding: Rounding{Policy: RoundPerIncome, Mode: models.RoundingTruncate},
+				},
+				taxType: TaxTypeEmployment,
+			},
+			wantTax: money("2.01", currencies.GEL),
+			wantPension: Pension{
+				// 0.205 is truncated, not rounded half-up to 0.21.
+				Employee: money("0.2", currencies.GEL),
+				Employer: money("0.2", currencies.GEL),
+				State:    money("0.2", currencies.GEL),
+			},
+		},
+		{
+			name: "before pension scheme start",
+			args: args{
+				income: Income{
+					Amount: money("1000", currencies.GEL),
+					Date:   date(2018, time.December, 31),
+				},
+				taxType: TaxTypeEmployment,
+			},
+			wantTax:     money("200", currencies.GEL),
+			wantPension: Pension{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Calc(tt.args.income, tt.args.taxType)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wantTax, got.Money)
+			assert.Equal(t, tt.wantPension, got.Pension)
+		})
+	}
+}
+
+func TestPension_Add(t *testing.T) {
+	var total Pension
+
+	total = total.Add(Pension{
+		Employee: money("20", currencies.GEL),
+		Employer: money("20", currencies.GEL),
+		State:    money("20", currencies.GEL),
+	})
+
+	total = total.Add(Pension{
+		Employee: money("4.5", currencies.GEL),
+		Employer: money("4.5", currencies.GEL),
+		State:    money("2.25", currencies.GEL),
+	})
+
+	assert.Equal(t, Pension{
+		Employee: money("24.5", currencies.GEL),
+		Employer: money("24.5", currencies.GEL),
+		State:    money("22.25", currencies.GEL),
+	}, total)
+
+	assert.Equal(t, money("71.25", currencies.GEL), total.Total())
+	assert.False(t, total.IsZero())
+	assert.True(t, Pension{}.IsZero())
+}
@@ -0,0 +1,218 @@
+package taxes
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/obalunenko/georgia-tax-calculator/internal/models"
+	"github.com/obalunenko/georgia-tax-calculator/pkg/moneyutils"
+)
+
+const (
+	onePercent     = 0.01
+	threePercents  = onePercent * 3
+	twentyPercents = onePercent * 20
+)
+
+// SmallBusinessYearLimit is an annual income limit in GEL for Small Business tax type.
+// Income above the limit is taxed at increased rate.
+const SmallBusinessYearLimit float64 = 500_000
+
+// threshold represents annual income limit and the rate applied to income above it.
+type threshold struct {
+	limit float64
+	rate  float64
+}
+
+// rateEntry represents tax rate in force during period [from, to).
+// Zero from means the rate has no start date, zero to means the rate is still in force.
+type rateEntry struct {
+	from      time.Time
+	to        time.Time
+	rate      float64
+	threshold *threshold
+	pension   pensionMode
+}
+
+func (e rateEntry) inForce(date time.Time) bool {
+	if !e.from.IsZero() && date.Before(e.from) {
+		return false
+	}
+
+	if !e.to.IsZero() && !date.Before(e.to) {
+		return false
+	}
+
+	return true
+}
+
+func (e rateEntry) taxRate(tt TaxType) TaxRate {
+	return newTaxRate(tt, e.rate)
+}
+
+// rateTables holds history of rates for each TaxType.
+// When the law changes, close the current entry by setting its to date
+// and add a new entry starting from the same date.
+var rateTables = map[TaxType][]rateEntry{
+	TaxTypeSmallBusiness: {
+		{
+			rate: onePercent,
+			threshold: &threshold{
+				limit: SmallBusinessYearLimit,
+				rate:  threePercents,
+			},
+			pension: pensionSelfEmployed,
+		},
+	},
+	TaxTypeIndividualEntrepreneur: {
+		{
+			rate:    threePercents,
+			pension: pensionSelfEmployed,
+		},
+	},
+	TaxTypeEmployment: {
+		{
+			rate:    twentyPercents,
+			pension: pensionWithheld,
+		},
+	},
+}
+
+// rateAt returns rate entry of TaxType in force on date.
+func rateAt(tt TaxType, date time.Time) (rateEntry, error) {
+	for _, e := range rateTables[tt] {
+		if e.inForce(date) {
+			return e, nil
+		}
+	}
+
+	return rateEntry{}, fmt.Errorf("%s on %s: %w", tt.String(), date.Format(time.DateOnly), ErrTaxRateNotFound)
+}
+
+// TaxRate represents tuple TaxType - rate.
+type TaxRate struct {
+	Type TaxType
+	Rate float64
+}
+
+func (t TaxRate) String() string {
+	return fmt.Sprintf("%s %s", t.Type.String(), formatRate(t.Rate))
+}
+
+func formatRate(rate float64) string {
+	const toPercentage int64 = 100
+
+	return fmt.Sprintf("%s %%", moneyutils.ToString(moneyutils.Multiply(moneyutils.FromFloat(rate), decimal.NewFromInt(toPercentage))))
+}
+
+func newTaxRate(tt TaxType, rate float64) TaxRate {
+	return TaxRate{
+		Type: tt,
+		Rate: rate,
+	}
+}
+
+// AllTaxRates returns all supported TaxRate in force today.
+func AllTaxRates() ([]TaxRate, error) {
+	return AllTaxRatesAt(time.Now())
+}
+
+// AllTaxRatesAt returns all supported TaxRate in force on date.
+func AllTaxRatesAt(date time.Time) ([]TaxRate, error) {
+	taxes := AllTaxTypes()
+
+	resp := make([]TaxRate, 0, len(taxes))
+
+	for _, tax := range taxes {
+		tr, err := tax.Rate(date)
+		if err != nil {
+			return nil, err
+		}
+
+		resp = append(resp, tr)
+	}
+
+	return resp, nil
+}
+
+// AllTaxTypes returns TaxType of all registered regimes in order of registration.
+func AllTaxTypes() []TaxType {
+	rs := Regimes()
+
+	taxes := make([]TaxType, 0, len(rs))
+
+	for _, r := range rs {
+		taxes = append(taxes, r.Type())
+	}
+
+	return taxes
+}
+
+// Rate returns TaxRate of TaxType in force on date.
+func (i TaxType) Rate(date time.Time) (TaxRate, error) {
+	r, err := Lookup(i)
+	if err != nil {
+		return TaxRate{}, err
+	}
+
+	return r.Rate(date)
+}
+
+// tableRegime is a Regime with rates taken from rateTables.
+type tableRegime struct {
+	tt TaxType
+}
+
+func (r tableRegime) Type() TaxType {
+	return r.tt
+}
+
+func (r tableRegime) Rate(date time.Time) (TaxRate, error) {
+	e, err := rateAt(r.tt, date)
+	if err != nil {
+		return TaxRate{}, err
+	}
+
+	return e.taxRate(r.tt), nil
+}
+
+// Calc returns tax for income.
+// When the rate has an annual threshold, the portion of income that exceeds it
+// (considering Income.YearIncome) is taxed at the increased rate.
+// Pension contributions are calculated as well; when employee share is withheld
+// it is deducted from income before tax is charged.
+func (r tableRegime) Calc(income Income) (Response, error) {
+	entry, err := rateAt(r.tt, income.Date)
+	if err != nil {
+		return Response{}, fmt.Errorf("get tax rate: %w", err)
+	}
+
+	tr := entry.taxRate(r.tt)
+
+	pension := calcPension(income, entry.pension)
+
+	taxable := income
+	if entry.pension == pensionWithheld {
+		taxable.Amount = models.NewMoney(
+			moneyutils.Sub(income.Amount.Amount, pension.Employee.Amount),
+			income.Amount.Currency,
+		)
+	}
+
+	parts := splitIncome(taxable, tr, entry.threshold)
+
+	var sum decimal.Decimal
+
+	for _, p := range parts {
+		sum = moneyutils.Add(sum, p.Tax.Amount)
+	}
+
+	return Response{
+		Money:   models.NewMoney(sum, income.Amount.Currency),
+		Rate:    tr,
+		Parts:   parts,
+		Pension: pension,
+	}, nil
+}
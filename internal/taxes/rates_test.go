@@ -0,0 +1,183 @@
+package taxes
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/obalunenko/georgia-tax-calculator/pkg/nbggovge/currencies"
+)
+
+// setRateTables replaces rate tables for the duration of the test.
+func setRateTables(tb testing.TB, tables map[TaxType][]rateEntry) {
+	tb.Helper()
+
+	orig := rateTables
+	rateTables = tables
+
+	tb.Cleanup(func() {
+		rateTables = orig
+	})
+}
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func historicalTables() map[TaxType][]rateEntry {
+	return map[TaxType][]rateEntry{
+		TaxTypeEmployment: {
+			{
+				to:   date(2020, time.January, 1),
+				rate: 0.25,
+			},
+			{
+				from: date(2020, time.January, 1),
+				rate: twentyPercents,
+			},
+		},
+		TaxTypeSmallBusiness: {
+			{
+				from: date(2019, time.January, 1),
+				to:   date(2020, time.January, 1),
+				rate: 0.05,
+				threshold: &threshold{
+					limit: 100_000,
+					rate:  0.1,
+				},
+			},
+			{
+				from: date(2020, time.January, 1),
+				rate: onePercent,
+				threshold: &threshold{
+					limit: SmallBusinessYearLimit,
+					rate:  threePercents,
+				},
+			},
+		},
+	}
+}
+
+func TestTaxType_Rate_Date(t *testing.T) {
+	setRateTables(t, historicalTables())
+
+	tests := []struct {
+		name    string
+		i       TaxType
+		date    time.Time
+		want    TaxRate
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name:    "employment before change",
+			i:       TaxTypeEmployment,
+			date:    date(2019, time.December, 31),
+			want:    TaxRate{Type: TaxTypeEmployment, Rate: 0.25},
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "employment on the day of change",
+			i:       TaxTypeEmployment,
+			date:    date(2020, time.January, 1),
+			want:    TaxRate{Type: TaxTypeEmployment, Rate: 0.2},
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "small business before first entry - error",
+			i:       TaxTypeSmallBusiness,
+			date:    date(2018, time.June, 1),
+			want:    TaxRate{},
+			wantErr: assert.Error,
+		},
+		{
+			name:    "small business old rate",
+			i:       TaxTypeSmallBusiness,
+			date:    date(2019, time.June, 1),
+			want:    TaxRate{Type: TaxTypeSmallBusiness, Rate: 0.05},
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "no table - error",
+			i:       TaxTypeIndividualEntrepreneur,
+			date:    date(2019, time.June, 1),
+			want:    TaxRate{},
+			wantErr: assert.Error,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.i.Rate(tt.date)
+			if !tt.wantErr(t, err) {
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCalc_RateInForceOnIncomeDate(t *testing.T) {
+	setRateTables(t, historicalTables())
+
+	got, err := Calc(Income{
+		Amount:     money("20000", currencies.GEL),
+		YearIncome: money("90000", currencies.GEL),
+		Date:       date(2019, time.June, 1),
+	}, TaxTypeSmallBusiness)
+	require.NoError(t, err)
+
+	assert.Equal(t, Response{
+		Money: money("1500", currencies.GEL),
+		Rate:  TaxRate{Type: TaxTypeSmallBusiness, Rate: 0.05},
+		Parts: []Part{
+			{
+				Base: money("10000", currencies.GEL),
+				Rate: 0.05,
+				Tax:  money("500", currencies.GEL),
+			},
+			{
+				Base:          money("10000", currencies.GEL),
+				Rate:          0.1,
+				Tax:           money("1000", currencies.GEL),
+				OverThreshold: true,
+			},
+		},
+	}, got)
+
+	got, err = Calc(Income{
+		Amount:     money("20000", currencies.GEL),
+		YearIncome: money("90000", currencies.GEL),
+		Date:       date(2020, time.June, 1),
+	}, TaxTypeSmallBusiness)
+	require.NoError(t, err)
+
+	assert.Equal(t, money("200", currencies.GEL), got.Money)
+
+	_, err = Calc(Income{
+		Amount: money("20000", currencies.GEL),
+		Date:   date(2018, time.June, 1),
+	}, TaxTypeSmallBusiness)
+	require.ErrorIs(t, err, ErrTaxRateNotFound)
+}
+
+func TestAllTaxRatesAt(t *testing.T) {
+	tables := historicalTables()
+	tables[TaxTypeIndividualEntrepreneur] = []rateEntry{{rate: threePercents}}
+
+	setRateTables(t, tables)
+
+	got, err := AllTaxRatesAt(date(2019, time.June, 1))
+	require.NoError(t, err)
+
+	assert.ElementsMatch(t, []TaxRate{
+		{Type: TaxTypeEmployment, Rate: 0.25},
+		{Type: TaxTypeSmallBusiness, Rate: 0.05},
+		{Type: TaxTypeIndividualEntrepreneur, Rate: 0.03},
+	}, got)
+
+	_, err = AllTaxRatesAt(date(2018, time.June, 1))
+	require.ErrorIs(t, err, ErrTaxRateNotFound)
+}
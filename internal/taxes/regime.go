@@ -0,0 +1,96 @@
+package taxes
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	// ErrInvalidRegime returned when Regime could not be registered.
+	ErrInvalidRegime = errors.New("invalid regime")
+	// ErrRegimeAlreadyRegistered returned when Regime with the same TaxType is registered already.
+	ErrRegimeAlreadyRegistered = errors.New("regime already registered")
+)
+
+// Regime is a tax regime that computes tax for an income.
+type Regime interface {
+	// Type returns TaxType the Regime is registered under.
+	Type() TaxType
+	// Rate returns base TaxRate in force on date.
+	Rate(date time.Time) (TaxRate, error)
+	// Calc returns tax for income in context of its date, year income and category.
+	Calc(income Income) (Response, error)
+}
+
+// registry holds registered regimes in order of registration.
+type registry struct {
+	mu      sync.RWMutex
+	regimes []Regime
+}
+
+var regimes = &registry{
+	regimes: []Regime{
+		tableRegime{tt: TaxTypeEmployment},
+		tableRegime{tt: TaxTypeSmallBusiness},
+		tableRegime{tt: TaxTypeIndividualEntrepreneur},
+	},
+}
+
+// Register adds Regime to the registry, so it could be parsed by ParseTaxType
+// and listed by AllTaxTypes. TaxType names are case-insensitive.
+func Register(r Regime) error {
+	if r == nil || strings.TrimSpace(r.Type().String()) == "" {
+		return ErrInvalidRegime
+	}
+
+	regimes.mu.Lock()
+	defer regimes.mu.Unlock()
+
+	if _, ok := regimes.find(r.Type().String()); ok {
+		return fmt.Errorf("%s: %w", r.Type().String(), ErrRegimeAlreadyRegistered)
+	}
+
+	regimes.regimes = append(regimes.regimes, r)
+
+	return nil
+}
+
+// Lookup returns Regime registered under TaxType.
+func Lookup(tt TaxType) (Regime, error) {
+	regimes.mu.RLock()
+	defer regimes.mu.RUnlock()
+
+	r, ok := regimes.find(tt.String())
+	if !ok {
+		return nil, fmt.Errorf("%s: %w", tt.String(), ErrTaxTypeNotSupported)
+	}
+
+	return r, nil
+}
+
+// Regimes returns all registered regimes in order of registration.
+func Regimes() []Regime {
+	regimes.mu.RLock()
+	defer regimes.mu.RUnlock()
+
+	resp := make([]Regime, len(regimes.regimes))
+	copy(resp, regimes.regimes)
+
+	return resp
+}
+
+// find returns Regime with TaxType name equal to raw ignoring case. Caller must hold the lock.
+func (r *registry) find(raw string) (Regime, bool) {
+	raw = strings.TrimSpace(raw)
+
+	for _, rg := range r.regimes {
+		if strings.EqualFold(rg.Type().String(), raw) {
+			return rg, true
+		}
+	}
+
+	return nil, false
+}
@@ -0,0 +1,165 @@
+package taxes
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/obalunenko/georgia-tax-calculator/pkg/nbggovge/currencies"
+)
+
+// restoreRegimes restores registered regimes after the test.
+func restoreRegimes(tb testing.TB) {
+	tb.Helper()
+
+	orig := Regimes()
+
+	tb.Cleanup(func() {
+		regimes.mu.Lock()
+		defer regimes.mu.Unlock()
+
+		regimes.regimes = orig
+	})
+}
+
+// royaltyRegime taxes royalties at 5% and other incomes at 10%.
+type royaltyRegime struct{}
+
+const taxTypeRoyalty TaxType = "Royalty"
+
+func (royaltyRegime) Type() TaxType {
+	return taxTypeRoyalty
+}
+
+func (royaltyRegime) Rate(time.Time) (TaxRate, error) {
+	return newTaxRate(taxTypeRoyalty, 0.1), nil
+}
+
+func (royaltyRegime) Calc(income Income) (Response, error) {
+	rate := 0.1
+	if income.Category == "royalty" {
+		rate = 0.05
+	}
+
+	p := newPart(income.Amount.Amount, income.Amount.Currency, rate, false, income.Rounding)
+
+	return Response{
+		Money: p.Tax,
+		Rate:  newTaxRate(taxTypeRoyalty, rate),
+		Parts: []Part{p},
+	}, nil
+}
+
+func TestRegister(t *testing.T) {
+	restoreRegimes(t)
+
+	require.NoError(t, Register(royaltyRegime{}))
+
+	assert.Equal(t, []TaxType{
+		TaxTypeEmployment, TaxTypeSmallBusiness, TaxTypeIndividualEntrepreneur, taxTypeRoyalty,
+	}, AllTaxTypes())
+
+	tt, err := ParseTaxType(" royalty ")
+	require.NoError(t, err)
+	assert.Equal(t, taxTypeRoyalty, tt)
+	assert.True(t, tt.Valid())
+
+	got, err := Calc(Income{
+		Amount:   money("1000", currencies.GEL),
+		Date:     time.Now(),
+		Category: "royalty",
+	}, tt)
+	require.NoError(t, err)
+	assert.Equal(t, money("50", currencies.GEL), got.Money)
+
+	got, err = Calc(Income{
+		Amount: money("1000", currencies.GEL),
+		Date:   time.Now(),
+	}, tt)
+	require.NoError(t, err)
+	assert.Equal(t, money("100", currencies.GEL), got.Money)
+
+	rates, err := AllTaxRates()
+	require.NoError(t, err)
+	assert.Contains(t, rates, TaxRate{Type: taxTypeRoyalty, Rate: 0.1})
+}
+
+func TestRegister_Errors(t *testing.T) {
+	restoreRegimes(t)
+
+	tests := []struct {
+		name    string
+		r       Regime
+		wantErr error
+	}{
+		{
+			name:    "nil regime",
+			r:       nil,
+			wantErr: ErrInvalidRegime,
+		},
+		{
+			name:    "empty type",
+			r:       tableRegime{tt: "  "},
+			wantErr: ErrInvalidRegime,
+		},
+		{
+			name:    "already registered",
+			r:       tableRegime{tt: TaxTypeEmployment},
+			wantErr: ErrRegimeAlreadyRegistered,
+		},
+		{
+			name:    "already registered - case-insensitive",
+			r:       tableRegime{tt: "small business"},
+			wantErr: ErrRegimeAlreadyRegistered,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.ErrorIs(t, Register(tt.r), tt.wantErr)
+		})
+	}
+
+	assert.Len(t, Regimes(), 3)
+}
+
+func TestParseTaxType(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     string
+		want    TaxType
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name:    "exact",
+			raw:     "Small Business",
+			want:    TaxTypeSmallBusiness,
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "case and spaces",
+			raw:     " individual entrepreneur\n",
+			want:    TaxTypeIndividualEntrepreneur,
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "not registered",
+			raw:     "Royalty",
+			want:    "",
+			wantErr: assert.Error,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseTaxType(tt.raw)
+			if !tt.wantErr(t, err) {
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package taxes
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/obalunenko/georgia-tax-calculator/internal/models"
+)
+
+// ErrInvalidRoundingPolicy returned when rounding policy is not supported.
+var ErrInvalidRoundingPolicy = errors.New("invalid rounding policy")
+
+// RoundingPolicy defines at which step tax is rounded to minor units.
+type RoundingPolicy string
+
+const (
+	// RoundPerIncome rounds tax of each income, total tax is a sum of rounded taxes.
+	RoundPerIncome RoundingPolicy = "per-income"
+	// RoundPerPeriod rounds total tax of each declaration period, i.e. calendar month.
+	RoundPerPeriod RoundingPolicy = "per-period"
+	// RoundAtEnd rounds only total tax of all incomes.
+	RoundAtEnd RoundingPolicy = "at-end"
+)
+
+func (p RoundingPolicy) String() string {
+	return string(p)
+}
+
+// RoundingPolicies returns all supported rounding policies.
+func RoundingPolicies() []RoundingPolicy {
+	return []RoundingPolicy{RoundPerIncome, RoundPerPeriod, RoundAtEnd}
+}
+
+// ParseRoundingPolicy parses RoundingPolicy from string, case-insensitive.
+func ParseRoundingPolicy(raw string) (RoundingPolicy, error) {
+	p := RoundingPolicy(strings.ToLower(strings.TrimSpace(raw)))
+
+	for _, policy := range RoundingPolicies() {
+		if p == policy {
+			return policy, nil
+		}
+	}
+
+	return "", fmt.Errorf("%q: %w", raw, ErrInvalidRoundingPolicy)
+}
+
+// Rounding is a policy and mode of rounding taxes.
+// Taxes of incomes and their parts are rounded only with RoundPerIncome policy. With other policies
+// they stay exact, so that totals are rounded once, and they are shown rounded with Mode.
+type Rounding struct {
+	Policy RoundingPolicy
+	Mode   models.RoundingMode
+}
+
+// DefaultRounding rounds tax of each income half-up.
+var DefaultRounding = Rounding{
+	Policy: RoundPerIncome,
+	Mode:   models.RoundingHalfUp,
+}
+
+// OrDefault returns rounding with empty fields set from DefaultRounding.
+func (r Rounding) OrDefault() Rounding {
+	if r.Policy == "" {
+		r.Policy = DefaultRounding.Policy
+	}
+
+	if r.Mode == "" {
+		r.Mode = DefaultRounding.Mode
+	}
+
+	return r
+}
+
+func (r Rounding) String() string {
+	return fmt.Sprintf("%s, %s", r.Policy, r.Mode)
+}
+
+// roundIncomeTax rounds tax of a single income when policy is RoundPerIncome.
+// Other policies keep exact tax, it is rounded when totals are summed.
+func (r Rounding) roundIncomeTax(tax models.Money) models.Money {
+	r = r.OrDefault()
+
+	if r.Policy != RoundPerIncome {
+		return tax
+	}
+
+	return tax.RoundWith(r.Mode)
+}
@@ -0,0 +1,82 @@
+package taxes
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/obalunenko/georgia-tax-calculator/internal/models"
+	"github.com/obalunenko/georgia-tax-calculator/pkg/nbggovge/currencies"
+)
+
+func TestParseRoundingPolicy(t *testing.T) {
+	got, err := ParseRoundingPolicy("Per-Period")
+	require.NoError(t, err)
+	assert.Equal(t, RoundPerPeriod, got)
+
+	_, err = ParseRoundingPolicy("per-year")
+	require.ErrorIs(t, err, ErrInvalidRoundingPolicy)
+}
+
+func TestRounding_OrDefault(t *testing.T) {
+	assert.Equal(t, DefaultRounding, Rounding{}.OrDefault())
+	assert.Equal(t,
+		Rounding{Policy: RoundAtEnd, Mode: models.RoundingHalfUp},
+		Rounding{Policy: RoundAtEnd}.OrDefault(),
+	)
+	assert.Equal(t,
+		Rounding{Policy: RoundPerIncome, Mode: models.RoundingTruncate},
+		Rounding{Mode: models.RoundingTruncate}.OrDefault(),
+	)
+}
+
+func TestCalc_Rounding(t *testing.T) {
+	date := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		rounding Rounding
+		want     models.Money
+	}{
+		{
+			name: "default",
+			want: money("0.13", currencies.GEL),
+		},
+		{
+			name:     "per income half-even",
+			rounding: Rounding{Policy: RoundPerIncome, Mode: models.RoundingHalfEven},
+			want:     money("0.12", currencies.GEL),
+		},
+		{
+			name:     "per income truncate",
+			rounding: Rounding{Policy: RoundPerIncome, Mode: models.RoundingTruncate},
+			want:     money("0.12", currencies.GEL),
+		},
+		{
+			name:     "per period keeps exact tax",
+			rounding: Rounding{Policy: RoundPerPeriod, Mode: models.RoundingHalfUp},
+			want:     money("0.125", currencies.GEL),
+		},
+		{
+			name:     "at end keeps exact tax",
+			rounding: Rounding{Policy: RoundAtEnd, Mode: models.RoundingHalfUp},
+			want:     money("0.125", currencies.GEL),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Calc(Income{
+				Amount:     money("12.5", currencies.GEL),
+				YearIncome: money("0", currencies.GEL),
+				Date:       date,
+				Rounding:   tt.rounding,
+			}, TaxTypeSmallBusiness)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.want, got.Money)
+		})
+	}
+}
@@ -124,6 +124,22 @@ func ParseYear(raw string) (int, error) {
 	return y, nil
 }
 
+// ErrInvalidDate returned when date is invalid.
+var ErrInvalidDate = errors.New("invalid date")
+
+// DateLayout is a layout of dates accepted by ParseDate.
+const DateLayout = time.DateOnly
+
+// ParseDate parses date in DateLayout format (e.g. 2023-06-08).
+func ParseDate(raw string) (time.Time, error) {
+	date, err := time.Parse(DateLayout, strings.TrimSpace(raw))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%s: expected YYYY-MM-DD: %w", raw, ErrInvalidDate)
+	}
+
+	return date, nil
+}
+
 // DaysList returns list of days with specified number of days.
 func DaysList(num int) []string {
 	res := make([]string, 0, num)
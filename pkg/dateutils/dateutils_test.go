@@ -315,3 +315,58 @@ type args struct {
 		})
 	}
 }
+
+func TestParseDate(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     string
+		want    time.Time
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name:    "valid",
+			raw:     "2023-06-08",
+			want:    time.Date(2023, time.June, 8, 0, 0, 0, 0, time.UTC),
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "spaces trimmed",
+			raw:     " 2024-02-29 ",
+			want:    time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC),
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "day out of range",
+			raw:     "2023-02-29",
+			want:    time.Time{},
+			wantErr: assert.Error,
+		},
+		{
+			name:    "wrong layout",
+			raw:     "08.06.2023",
+			want:    time.Time{},
+			wantErr: assert.Error,
+		},
+		{
+			name:    "empty",
+			raw:     "",
+			want:    time.Time{},
+			wantErr: assert.Error,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseDate(tt.raw)
+			if !tt.wantErr(t, err, fmt.Sprintf("ParseDate(%v)", tt.raw)) {
+				return
+			}
+
+			if err != nil {
+				assert.ErrorIs(t, err, ErrInvalidDate)
+			}
+
+			assert.Equalf(t, tt.want, got, "ParseDate(%v)", tt.raw)
+		})
+	}
+}
@@ -1,70 +1,58 @@
 // Package moneyutils provide functionality for work with money.
+// Amounts are exact decimals, floats are converted only at boundaries, e.g. rates received from API.
 package moneyutils
 
 import (
 	"github.com/shopspring/decimal"
 )
 
-// Multiply returns result of multiplication of two float64.
-func Multiply(a, b float64) float64 {
-	d := multiply(decimal.NewFromFloat(a), decimal.NewFromFloat(b))
-
-	return d.InexactFloat64()
-}
-
-// Div returns result of div of two float64.
-func Div(a, b float64) float64 {
-	d := div(decimal.NewFromFloat(a), decimal.NewFromFloat(b))
-
-	return d.InexactFloat64()
+// Multiply returns result of multiplication of two decimals.
+func Multiply(a, b decimal.Decimal) decimal.Decimal {
+	return a.Mul(b)
 }
 
-// Add returns sum of two floats.
-func Add(a, b float64) float64 {
-	s := add(decimal.NewFromFloat(a), decimal.NewFromFloat(b))
-
-	return s.InexactFloat64()
+// Div returns result of div of two decimals with decimal.DivisionPrecision decimal places.
+func Div(a, b decimal.Decimal) decimal.Decimal {
+	return a.Div(b)
 }
 
-func add(a, b decimal.Decimal) decimal.Decimal {
+// Add returns sum of two decimals.
+func Add(a, b decimal.Decimal) decimal.Decimal {
 	return a.Add(b)
 }
 
-func div(a, b decimal.Decimal) decimal.Decimal {
-	return a.Div(b)
-}
-
-func multiply(a, b decimal.Decimal) decimal.Decimal {
-	return a.Mul(b)
+// Sub returns result of subtraction b from a.
+func Sub(a, b decimal.Decimal) decimal.Decimal {
+	return a.Sub(b)
 }
 
 // Round rounds the decimal to places decimal places.
 // If places < 0, it will round the integer part to the nearest 10^(-places).
-func Round(a float64, places int32) float64 {
-	rounded := round(decimal.NewFromFloat(a), places)
-
-	return rounded.InexactFloat64()
+func Round(a decimal.Decimal, places int32) decimal.Decimal {
+	return a.Round(places)
 }
 
-// Parse float from string.
-func Parse(raw string) (float64, error) {
-	d, err := decimal.NewFromString(raw)
-	if err != nil {
-		return 0, err
+// Normalize returns decimal in canonical form: without trailing zeros and zero value for zero.
+// Equal normalized decimals have equal representation, so structs holding them are deeply equal.
+func Normalize(d decimal.Decimal) decimal.Decimal {
+	if d.IsZero() {
+		return decimal.Decimal{}
 	}
 
-	return d.InexactFloat64(), nil
+	return decimal.RequireFromString(d.String())
 }
 
-// ToString converts float to string.
-func ToString(v float64) string {
-	d := decimal.NewFromFloat(v)
-
-	return d.String()
+// FromFloat converts float to decimal with the shortest representation of float, so 0.1 is exactly 0.1.
+func FromFloat(f float64) decimal.Decimal {
+	return decimal.NewFromFloat(f)
 }
 
-func round(amount decimal.Decimal, places int32) decimal.Decimal {
-	rounded := amount.Round(places)
+// ToFloat converts decimal to float for display, precision could be lost.
+func ToFloat(d decimal.Decimal) float64 {
+	return d.InexactFloat64()
+}
 
-	return rounded
+// ToString converts decimal to string.
+func ToString(v decimal.Decimal) string {
+	return v.String()
 }
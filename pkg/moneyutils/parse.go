@@ -0,0 +1,330 @@
+package moneyutils
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/obalunenko/georgia-tax-calculator/pkg/nbggovge/currencies"
+)
+
+var (
+	// ErrInvalidAmount returned when string is not an amount.
+	ErrInvalidAmount = errors.New("invalid amount")
+	// ErrAmbiguousAmount returned when amount could be read differently depending on locale.
+	ErrAmbiguousAmount = errors.New("ambiguous amount")
+)
+
+// Amount is a parsed amount with currency written along with it.
+type Amount struct {
+	Value decimal.Decimal
+	// Currency is a code of currency symbol or code written with amount, empty when there was none.
+	Currency string
+}
+
+// currencySymbols are symbols of currencies that are written instead of codes.
+// Dollar sign is read as USD, as it is the most common dollar in Georgia.
+var currencySymbols = map[rune]string{
+	'$': currencies.USD,
+	'€': currencies.EUR,
+	'£': currencies.GBP,
+	'₾': currencies.GEL,
+	'₽': currencies.RUB,
+	'₴': currencies.UAH,
+	'₺': currencies.TRY,
+	'₹': currencies.INR,
+	'₸': currencies.KZT,
+	'₩': currencies.KRW,
+	'₪': currencies.ILS,
+	'₼': currencies.AZN,
+	'֏': currencies.AMD,
+}
+
+// ambiguousSymbols are symbols shared by several currencies.
+var ambiguousSymbols = map[rune]string{
+	'¥': currencies.JPY + " or " + currencies.CNY,
+}
+
+const (
+	currencyCodeLen = 3
+	groupLen        = 3
+)
+
+// Parse parses amount from string. Besides plain decimals, amounts with thousands separators,
+// decimal comma and currency symbol or code are accepted, see ParseAmount. Currency is ignored.
+func Parse(raw string) (decimal.Decimal, error) {
+	a, err := ParseAmount(raw)
+	if err != nil {
+		return decimal.Decimal{}, err
+	}
+
+	return a.Value, nil
+}
+
+// ParseAmount parses amount as it is written by people in different locales, e.g. 1,250.00, 1 250,00,
+// 1.250,00, $1,250, €99 or 99 EUR. Separator is a decimal one unless it is repeated or followed by another one.
+//
+// Single separator followed by exactly three digits is read differently in different locales,
+// so such amounts are accepted only when position of currency tells the convention:
+// $1,250 is 1250, as comma separates thousands when currency is written before amount.
+// 1,250 and 1.250 € are rejected with ErrAmbiguousAmount, while 1.250 is a plain decimal 1.25.
+func ParseAmount(raw string) (Amount, error) {
+	s := strings.TrimSpace(raw)
+
+	if d, err := decimal.NewFromString(s); err == nil {
+		return Amount{Value: d}, nil
+	}
+
+	n, err := splitCurrency(s)
+	if err != nil {
+		return Amount{}, fmt.Errorf("%q: %w", raw, err)
+	}
+
+	plain, err := n.plain()
+	if err != nil {
+		return Amount{}, fmt.Errorf("%q: %w", raw, err)
+	}
+
+	d, err := decimal.NewFromString(plain)
+	if err != nil {
+		return Amount{}, fmt.Errorf("%q: %w", raw, ErrInvalidAmount)
+	}
+
+	return Amount{Value: d, Currency: n.currency}, nil
+}
+
+// number is an amount without currency.
+type number struct {
+	digits   string
+	negative bool
+	currency string
+	// prefix is true when currency is written before amount.
+	prefix bool
+}
+
+// splitCurrency cuts sign and currency symbol or code written before or after amount.
+func splitCurrency(s string) (number, error) {
+	var n number
+
+	s, n.negative = strings.CutPrefix(s, "-")
+
+	rest, code, err := cutCurrency(s, true)
+	if err != nil {
+		return number{}, err
+	}
+
+	if code != "" {
+		n.currency, n.prefix = code, true
+
+		if !n.negative {
+			rest, n.negative = strings.CutPrefix(strings.TrimSpace(rest), "-")
+		}
+	} else {
+		rest, code, err = cutCurrency(s, false)
+		if err != nil {
+			return number{}, err
+		}
+
+		n.currency = code
+	}
+
+	n.digits = strings.TrimSpace(rest)
+
+	if n.digits == "" {
+		return number{}, fmt.Errorf("%w: no digits", ErrInvalidAmount)
+	}
+
+	return n, nil
+}
+
+// cutCurrency cuts currency symbol or code from the beginning or the end of s.
+func cutCurrency(s string, prefix bool) (string, string, error) {
+	r, size := utf8.DecodeRuneInString(s)
+	if !prefix {
+		r, size = utf8.DecodeLastRuneInString(s)
+	}
+
+	rest := func(n int) string {
+		if prefix {
+			return s[n:]
+		}
+
+		return s[:len(s)-n]
+	}
+
+	if code, ok := currencySymbols[r]; ok {
+		return rest(size), code, nil
+	}
+
+	if codes, ok := ambiguousSymbols[r]; ok {
+		return "", "", fmt.Errorf("%w: %c could be %s, write currency code instead", ErrAmbiguousAmount, r, codes)
+	}
+
+	if len(s) < currencyCodeLen {
+		return s, "", nil
+	}
+
+	word := s[:currencyCodeLen]
+	if !prefix {
+		word = s[len(s)-currencyCodeLen:]
+	}
+
+	if strings.IndexFunc(word, func(r rune) bool { return r > unicode.MaxASCII || !unicode.IsLetter(r) }) != -1 {
+		return s, "", nil
+	}
+
+	code := strings.ToUpper(word)
+
+	if _, ok := currencies.Lookup(code); !ok {
+		return "", "", fmt.Errorf("%w: unknown currency %s", ErrInvalidAmount, word)
+	}
+
+	return rest(currencyCodeLen), code, nil
+}
+
+// isGroupSpace reports whether r is a space or apostrophe that separates thousands, e.g. 1 250 or 1'250.
+func isGroupSpace(r rune) bool {
+	return r == '\'' || unicode.IsSpace(r)
+}
+
+// plain returns number as a plain decimal string, e.g. -1250.5.
+func (n number) plain() (string, error) {
+	var dots, commas, spaces int
+
+	for _, r := range n.digits {
+		switch {
+		case r == '.':
+			dots++
+		case r == ',':
+			commas++
+		case isGroupSpace(r):
+			spaces++
+		case r < '0' || r > '9':
+			return "", fmt.Errorf("%w: unexpected %q", ErrInvalidAmount, r)
+		}
+	}
+
+	decSep, err := n.decimalSeparator(dots, commas, spaces)
+	if err != nil {
+		return "", err
+	}
+
+	intPart, frac := n.digits, ""
+
+	if decSep != 0 {
+		i := strings.LastIndexByte(n.digits, decSep)
+		intPart, frac = n.digits[:i], n.digits[i+1:]
+
+		if frac == "" || strings.ContainsFunc(frac, func(r rune) bool { return r < '0' || r > '9' }) {
+			return "", fmt.Errorf("%w: %c must be followed by digits only", ErrInvalidAmount, decSep)
+		}
+	}
+
+	intPart, err = ungroup(intPart)
+	if err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+
+	if n.negative {
+		b.WriteByte('-')
+	}
+
+	b.WriteString(intPart)
+
+	if frac != "" {
+		b.WriteString("." + frac)
+	}
+
+	return b.String(), nil
+}
+
+// decimalSeparator returns separator of fraction, zero when number is whole.
+func (n number) decimalSeparator(dots, commas, spaces int) (byte, error) {
+	switch {
+	case dots > 0 && commas > 0:
+		// The last one separates fraction, e.g. 1,250.00 or 1.250,00.
+		sep := byte('.')
+		if strings.LastIndexByte(n.digits, ',') > strings.LastIndexByte(n.digits, '.') {
+			sep = ','
+		}
+
+		if strings.Count(n.digits, string(sep)) > 1 {
+			return 0, fmt.Errorf("%w: %c is used as both thousands and decimal separator", ErrInvalidAmount, sep)
+		}
+
+		return sep, nil
+	case dots == 1:
+		return n.singleSeparator('.', spaces)
+	case commas == 1:
+		return n.singleSeparator(',', spaces)
+	default:
+		// Repeated separator separates thousands.
+		return 0, nil
+	}
+}
+
+// singleSeparator returns sep when the only separator of number is a decimal one.
+func (n number) singleSeparator(sep byte, spaces int) (byte, error) {
+	intPart, frac, _ := strings.Cut(n.digits, string(sep))
+
+	switch {
+	case len(frac) != groupLen, spaces > 0, strings.TrimLeft(intPart, "0") == "":
+		// Thousands are not separated by different separators, and are not separated after zero.
+		return sep, nil
+	case sep == ',' && n.prefix:
+		return 0, nil
+	case sep == '.' && (n.prefix || n.currency == ""):
+		return sep, nil
+	default:
+		return 0, fmt.Errorf("%w: %q could separate thousands or decimals, write %s%s for thousands or %s.%s for decimals",
+			ErrAmbiguousAmount, sep, intPart, frac, intPart, frac)
+	}
+}
+
+// ungroup removes thousands separators from integer part, checking that they separate groups of three digits.
+func ungroup(s string) (string, error) {
+	if s == "" {
+		return "0", nil
+	}
+
+	var (
+		groups []string
+		sep    rune
+		start  int
+	)
+
+	for i, r := range s {
+		if r >= '0' && r <= '9' {
+			continue
+		}
+
+		groups = append(groups, s[start:i])
+		start = i + utf8.RuneLen(r)
+
+		if isGroupSpace(r) {
+			r = ' '
+		}
+
+		if sep != 0 && r != sep {
+			return "", fmt.Errorf("%w: thousands are separated by both %q and %q", ErrInvalidAmount, sep, r)
+		}
+
+		sep = r
+	}
+
+	groups = append(groups, s[start:])
+
+	for i, g := range groups {
+		if (i == 0 && (g == "" || len(g) > groupLen) && len(groups) > 1) || (i > 0 && len(g) != groupLen) {
+			return "", fmt.Errorf("%w: thousands must be separated by groups of %d digits", ErrInvalidAmount, groupLen)
+		}
+	}
+
+	return strings.Join(groups, ""), nil
+}
@@ -0,0 +1,80 @@
+package moneyutils
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseAmount(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     string
+		want    Amount
+		wantErr error
+	}{
+		{name: "plain", raw: "1250.5", want: Amount{Value: decimal.RequireFromString("1250.5")}},
+		{name: "plain negative", raw: " -0.5 ", want: Amount{Value: decimal.RequireFromString("-0.5")}},
+		{name: "plain dot with three digits", raw: "1.250", want: Amount{Value: decimal.RequireFromString("1.25")}},
+		{name: "comma thousands, dot decimals", raw: "1,250.00", want: Amount{Value: decimal.RequireFromString("1250")}},
+		{name: "dot thousands, comma decimals", raw: "1.250,00", want: Amount{Value: decimal.RequireFromString("1250")}},
+		{name: "space thousands, comma decimals", raw: "1 250,00", want: Amount{Value: decimal.RequireFromString("1250")}},
+		{name: "no-break space thousands", raw: "1 250 000", want: Amount{Value: decimal.RequireFromString("1250000")}},
+		{name: "apostrophe thousands", raw: "1'250.5", want: Amount{Value: decimal.RequireFromString("1250.5")}},
+		{name: "repeated comma", raw: "1,250,000", want: Amount{Value: decimal.RequireFromString("1250000")}},
+		{name: "repeated dot", raw: "1.250.000", want: Amount{Value: decimal.RequireFromString("1250000")}},
+		{name: "comma decimals", raw: "99,5", want: Amount{Value: decimal.RequireFromString("99.5")}},
+		{name: "comma after zero", raw: "0,125", want: Amount{Value: decimal.RequireFromString("0.125")}},
+		{name: "dollar", raw: "$1,250", want: Amount{Value: decimal.RequireFromString("1250"), Currency: "USD"}},
+		{name: "dollar with dot", raw: "$1.250", want: Amount{Value: decimal.RequireFromString("1.25"), Currency: "USD"}},
+		{name: "negative dollar", raw: "-$5", want: Amount{Value: decimal.RequireFromString("-5"), Currency: "USD"}},
+		{name: "dollar negative", raw: "$-5", want: Amount{Value: decimal.RequireFromString("-5"), Currency: "USD"}},
+		{name: "euro", raw: "€99", want: Amount{Value: decimal.RequireFromString("99"), Currency: "EUR"}},
+		{name: "lari after amount", raw: "1 250,50 ₾", want: Amount{Value: decimal.RequireFromString("1250.5"), Currency: "GEL"}},
+		{name: "code after amount", raw: "99 EUR", want: Amount{Value: decimal.RequireFromString("99"), Currency: "EUR"}},
+		{name: "lowercase code before amount", raw: "usd 12,5", want: Amount{Value: decimal.RequireFromString("12.5"), Currency: "USD"}},
+		{name: "comma with three digits", raw: "1,250", wantErr: ErrAmbiguousAmount},
+		{name: "dot with three digits and currency after", raw: "1.250 €", wantErr: ErrAmbiguousAmount},
+		{name: "comma with three digits and currency after", raw: "1,250 EUR", wantErr: ErrAmbiguousAmount},
+		{name: "yen", raw: "¥100", wantErr: ErrAmbiguousAmount},
+		{name: "empty", raw: " ", wantErr: ErrInvalidAmount},
+		{name: "only currency", raw: "EUR", wantErr: ErrInvalidAmount},
+		{name: "unknown currency", raw: "10 XYZ", wantErr: ErrInvalidAmount},
+		{name: "letters", raw: "ten", wantErr: ErrInvalidAmount},
+		{name: "wrong groups", raw: "1,25,000", wantErr: ErrInvalidAmount},
+		{name: "double separator", raw: "1,,250", wantErr: ErrInvalidAmount},
+		{name: "repeated decimal separator", raw: "1,250.000.5", wantErr: ErrInvalidAmount},
+		{name: "mixed thousands separators", raw: "1 250.000,00", wantErr: ErrInvalidAmount},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseAmount(tt.raw)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			if assert.NoError(t, err) {
+				assert.True(t, tt.want.Value.Equal(got.Value), "want %s, got %s", tt.want.Value, got.Value)
+				assert.Equal(t, tt.want.Currency, got.Currency)
+			}
+		})
+	}
+}
+
+func TestParseAmount_AmbiguousExplanation(t *testing.T) {
+	_, err := ParseAmount("1,250")
+
+	assert.EqualError(t, err, `"1,250": ambiguous amount: ',' could separate thousands or decimals, `+
+		`write 1250 for thousands or 1.250 for decimals`)
+}
+
+func TestParse(t *testing.T) {
+	got, err := Parse("$1,250.50")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "1250.5", got.String())
+}
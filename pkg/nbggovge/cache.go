@@ -2,11 +2,13 @@
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"hash/crc32"
 	"sort"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/obalunenko/georgia-tax-calculator/pkg/nbggovge/internal"
@@ -14,37 +16,75 @@
 )
 
 // CachedClient wraps nbggovge client with caching functionality.
+// Concurrent identical requests share one in-flight fetch, and upstream failures
+// are cached for a short negative TTL to not flood the API with retries.
 type CachedClient struct {
-	client Client
-	cache  map[string]cachedRates
-	mutex  sync.RWMutex
-	ttl    time.Duration
+	client      Client
+	store       CacheStore
+	mutex       sync.RWMutex
+	ttl         time.Duration
+	negativeTTL time.Duration
+	calls       map[string]*call
+	failures    map[string]failure
+	index       cacheIndex
+	loadOnce    sync.Once
+	lru         *lru
+
+	hits            atomic.Int64
+	misses          atomic.Int64
+	evictions       atomic.Int64
+	upstreamCalls   atomic.Int64
+	upstreamLatency atomic.Int64
+}
+
+// call is an in-flight fetch of rates shared by concurrent identical requests.
+type call struct {
+	done  chan struct{}
+	rates Rates
+	err   error
 }
 
-// cachedRates holds cached rates data with timestamp.
-type cachedRates struct {
-	rates     Rates
+// failure is an upstream error cached for negative TTL.
+type failure struct {
+	err       error
 	timestamp time.Time
 }
 
+// DefaultNegativeTTL is a default time for which upstream failures are cached.
+const DefaultNegativeTTL = 5 * time.Second
+
 // NewCachedClient creates a new cached client with specified TTL.
 // If ttl is 0, cache entries will not expire (cache forever until program restart).
-func NewCachedClient(client Client, ttl time.Duration) *CachedClient {
-	return &CachedClient{
-		client: client,
-		cache:  make(map[string]cachedRates),
-		ttl:    ttl,
+// Rates of past dates never change, so they are cached permanently regardless of ttl.
+// By default, rates are cached in memory, use WithCacheDir to persist them on disk.
+func NewCachedClient(client Client, ttl time.Duration, opts ...CacheOption) *CachedClient {
+	c := &CachedClient{
+		client:      client,
+		store:       NewMemoryCacheStore(),
+		ttl:         ttl,
+		negativeTTL: DefaultNegativeTTL,
+		calls:       make(map[string]*call),
+		failures:    make(map[string]failure),
+		index:       make(cacheIndex),
+		lru:         newLRU(0),
+	}
+
+	for _, opt := range opts {
+		opt(c)
 	}
+
+	return c
 }
 
 // NewCachedClientWithDefaultTTL creates a new cached client with default TTL of 1 hour.
-func NewCachedClientWithDefaultTTL(client Client) *CachedClient {
-	return NewCachedClient(client, time.Hour)
+func NewCachedClientWithDefaultTTL(client Client, opts ...CacheOption) *CachedClient {
+	return NewCachedClient(client, time.Hour, opts...)
 }
 
-// Rates returns rates with caching. If rates for the same date and currencies
-// are already cached and not expired, returns cached data. Otherwise, fetches
-// new data from the API and caches it.
+// Rates returns rates with caching. If rates for the same date and currencies,
+// or for the same date and any superset of currencies, are already cached and not expired,
+// returns cached data. Otherwise, fetches new data from the API and caches it.
+// When several currencies are requested, rates of all currencies of the date are fetched.
 func (c *CachedClient) Rates(ctx context.Context, opts ...option.RatesOption) (Rates, error) {
 	// Parse options to get parameters
 	var params internal.RatesParams
@@ -56,39 +96,193 @@ func (c *CachedClient) Rates(ctx context.Context, opts ...option.RatesOption) (R
 		params.Date = time.Now()
 	}
 
+	params.CurrencyCodes = sortedCodes(params.CurrencyCodes)
+
 	// Generate cache key
-	cacheKey := c.generateCacheKey(params.Date, params.CurrencyCodes)
+	cacheKey := c.generateCacheKey(params.Date, params.CurrencyCodes, params.Language)
+
+	c.loadOnce.Do(c.loadStore)
 
 	// Try to get from cache first
 	c.mutex.RLock()
-	if cached, exists := c.cache[cacheKey]; exists {
-		// Check if cache entry is still valid
-		if c.ttl == 0 || time.Since(cached.timestamp) < c.ttl {
-			c.mutex.RUnlock()
-			return cached.rates, nil
-		}
-	}
+	rates, hitKey, exists := c.lookup(cacheKey, params)
 	c.mutex.RUnlock()
 
+	if exists {
+		c.hits.Add(1)
+		c.lru.touchIfPresent(hitKey)
+
+		return rates, nil
+	}
+
+	c.misses.Add(1)
+
 	// Cache miss or expired, fetch from API
-	rates, err := c.client.Rates(ctx, opts...)
-	if err != nil {
-		return Rates{}, err
+	if codes := params.CurrencyCodes; preferFullDay(codes) {
+		day := internal.RatesParams{Date: params.Date, Language: params.Language}
+
+		dayOpts := []option.RatesOption{option.WithDate(params.Date)}
+		if params.Language != "" {
+			dayOpts = append(dayOpts, option.WithLanguage(params.Language))
+		}
+
+		all, err := c.fetch(ctx, c.generateCacheKey(day.Date, nil, day.Language), day, dayOpts)
+		if err != nil {
+			return Rates{}, err
+		}
+
+		return filterRates(all, codes), nil
+	}
+
+	return c.fetch(ctx, cacheKey, params, opts)
+}
+
+// lookup returns valid cached rates of request with params stored by key or by any entry of index group
+// with superset of codes along with the key of found entry.
+func (c *CachedClient) lookup(key string, params internal.RatesParams) (Rates, string, bool) {
+	if cached, exists := c.store.Get(key); exists && !c.expired(cached) && cached.requested(params) {
+		return cached.Rates, key, true
 	}
 
-	// Store in cache
+	codes := params.CurrencyCodes
+
+	for _, k := range c.index.supersets(indexGroup(params.Date.Format(time.DateOnly), params.Language), codes) {
+		if k == key {
+			continue
+		}
+
+		if cached, exists := c.store.Get(k); exists && !c.expired(cached) {
+			return filterRates(cached.Rates, codes), k, true
+		}
+	}
+
+	return Rates{}, "", false
+}
+
+// fetch fetches rates from the API and caches them by key along with params of request.
+// Only one fetch per key is in flight, concurrent callers wait for its result.
+func (c *CachedClient) fetch(ctx context.Context, key string, params internal.RatesParams, opts []option.RatesOption) (Rates, error) {
+	c.mutex.Lock()
+
+	// Rates could be stored by another fetch while waiting for the lock.
+	if cached, exists := c.store.Get(key); exists && !c.expired(cached) && cached.requested(params) {
+		c.mutex.Unlock()
+
+		return cached.Rates, nil
+	}
+
+	if f, exists := c.failures[key]; exists {
+		if time.Since(f.timestamp) < c.negativeTTL {
+			c.mutex.Unlock()
+
+			return Rates{}, f.err
+		}
+
+		delete(c.failures, key)
+	}
+
+	if cl, exists := c.calls[key]; exists {
+		c.mutex.Unlock()
+
+		return c.wait(ctx, cl, key, params, opts)
+	}
+
+	cl := &call{done: make(chan struct{})}
+	c.calls[key] = cl
+
+	c.mutex.Unlock()
+
+	start := time.Now()
+
+	cl.rates, cl.err = c.client.Rates(ctx, opts...)
+
+	now := time.Now()
+
+	c.upstreamCalls.Add(1)
+	c.upstreamLatency.Add(int64(now.Sub(start)))
+
 	c.mutex.Lock()
-	c.cache[cacheKey] = cachedRates{
-		rates:     rates,
-		timestamp: time.Now(),
+
+	delete(c.calls, key)
+
+	if cl.err == nil {
+		delete(c.failures, key)
+
+		day := params.Date.Format(time.DateOnly)
+
+		// Rates are already fetched, so failed write only means the next call fetches them again.
+		if err := c.store.Set(key, CacheEntry{
+			Rates:     cl.rates,
+			Timestamp: now,
+			Permanent: isPastDate(params.Date, now),
+			Date:      day,
+			Codes:     params.CurrencyCodes,
+			Language:  params.Language,
+		}); err == nil {
+			c.index.add(indexGroup(day, params.Language), key, params.CurrencyCodes)
+			c.evict(c.lru.add(key))
+		}
+	} else if c.negativeTTL > 0 && !isContextError(cl.err) {
+		c.failures[key] = failure{err: cl.err, timestamp: now}
 	}
+
 	c.mutex.Unlock()
 
-	return rates, nil
+	close(cl.done)
+
+	return cl.rates, cl.err
+}
+
+// wait waits for result of in-flight fetch started by another caller.
+func (c *CachedClient) wait(ctx context.Context, cl *call, key string, params internal.RatesParams, opts []option.RatesOption) (
+	Rates, error,
+) {
+	select {
+	case <-ctx.Done():
+		return Rates{}, ctx.Err()
+	case <-cl.done:
+	}
+
+	// Fetch was canceled by context of the caller that started it, not of this one.
+	if isContextError(cl.err) && ctx.Err() == nil {
+		return c.fetch(ctx, key, params, opts)
+	}
+
+	return cl.rates, cl.err
 }
 
-// generateCacheKey creates a unique cache key based on date and currency codes.
-func (c *CachedClient) generateCacheKey(date time.Time, currencyCodes []string) string {
+// evict removes entries of keys from cache. Must be called with mutex locked.
+func (c *CachedClient) evict(keys []string) {
+	for _, key := range keys {
+		_ = c.store.Delete(key)
+
+		c.index.remove(key)
+		c.evictions.Add(1)
+	}
+}
+
+func isContextError(err error) bool {
+	return errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
+
+// expired reports whether cache entry should be fetched again.
+func (c *CachedClient) expired(e CacheEntry) bool {
+	if e.Permanent || c.ttl == 0 {
+		return false
+	}
+
+	return time.Since(e.Timestamp) >= c.ttl
+}
+
+// isPastDate reports whether date is before the day of now.
+func isPastDate(date, now time.Time) bool {
+	return date.Format(time.DateOnly) < now.In(date.Location()).Format(time.DateOnly)
+}
+
+// generateCacheKey creates a unique cache key based on date, currency codes and language.
+// Empty language is not a part of the key, so keys of default language are compatible with
+// entries stored before language was supported.
+func (c *CachedClient) generateCacheKey(date time.Time, currencyCodes []string, lang string) string {
 	// Format date as YYYY-MM-DD
 	dateStr := date.Format("2006-01-02")
 
@@ -99,6 +293,9 @@ func (c *CachedClient) generateCacheKey(date time.Time, currencyCodes []string)
 
 	// Create key: date + sorted currencies
 	keyData := dateStr + ":" + strings.Join(sortedCodes, ",")
+	if lang != "" {
+		keyData += ":" + lang
+	}
 
 	// Use CRC32 hash for shorter, consistent keys
 	hash := crc32.ChecksumIEEE([]byte(keyData))
@@ -109,29 +306,85 @@ func (c *CachedClient) generateCacheKey(date time.Time, currencyCodes []string)
 func (c *CachedClient) ClearCache() {
 	c.mutex.Lock()
 	defer c.mutex.Unlock()
-	c.cache = make(map[string]cachedRates)
+
+	clear(c.failures)
+	clear(c.index)
+	c.lru.clear()
+
+	_ = c.store.Clear()
 }
 
-// ClearExpired removes expired cache entries.
+// ClearExpired removes expired cache entries and upstream failures cached longer than negative TTL.
 func (c *CachedClient) ClearExpired() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	for key, f := range c.failures {
+		if time.Since(f.timestamp) >= c.negativeTTL {
+			delete(c.failures, key)
+		}
+	}
+
 	if c.ttl == 0 {
 		return // No expiration when TTL is 0
 	}
 
-	c.mutex.Lock()
-	defer c.mutex.Unlock()
+	for _, key := range c.store.Keys() {
+		if cached, ok := c.store.Get(key); !ok || c.expired(cached) {
+			_ = c.store.Delete(key)
 
-	for key, cached := range c.cache {
-		if time.Since(cached.timestamp) >= c.ttl {
-			delete(c.cache, key)
+			c.index.remove(key)
+			c.lru.remove(key)
 		}
 	}
 }
 
+// StartJanitor starts background goroutine that removes expired entries every interval
+// until ctx is done. It is useful for long-running programs, which never call ClearExpired.
+// Janitor is not started when interval is not positive.
+func (c *CachedClient) StartJanitor(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				c.ClearExpired()
+			}
+		}
+	}()
+}
+
 // CacheStats returns statistics about the cache.
 type CacheStats struct {
 	TotalEntries   int
 	ExpiredEntries int
+	// Hits is a number of requests answered from the cache.
+	Hits int64
+	// Misses is a number of requests not found in the cache.
+	Misses int64
+	// Evictions is a number of entries removed to keep the cache within max entries.
+	Evictions int64
+	// UpstreamCalls is a number of requests to the API.
+	UpstreamCalls int64
+	// UpstreamLatency is a total duration of requests to the API.
+	UpstreamLatency time.Duration
+}
+
+// AvgUpstreamLatency returns average duration of request to the API.
+func (s CacheStats) AvgUpstreamLatency() time.Duration {
+	if s.UpstreamCalls == 0 {
+		return 0
+	}
+
+	return s.UpstreamLatency / time.Duration(s.UpstreamCalls)
 }
 
 // GetCacheStats returns current cache statistics.
@@ -139,15 +392,20 @@ func (c *CachedClient) GetCacheStats() CacheStats {
 	c.mutex.RLock()
 	defer c.mutex.RUnlock()
 
+	keys := c.store.Keys()
+
 	stats := CacheStats{
-		TotalEntries: len(c.cache),
+		TotalEntries:    len(keys),
+		Hits:            c.hits.Load(),
+		Misses:          c.misses.Load(),
+		Evictions:       c.evictions.Load(),
+		UpstreamCalls:   c.upstreamCalls.Load(),
+		UpstreamLatency: time.Duration(c.upstreamLatency.Load()),
 	}
 
-	if c.ttl > 0 {
-		for _, cached := range c.cache {
-			if time.Since(cached.timestamp) >= c.ttl {
-				stats.ExpiredEntries++
-			}
+	for _, key := range keys {
+		if cached, ok := c.store.Get(key); !ok || c.expired(cached) {
+			stats.ExpiredEntries++
 		}
 	}
 
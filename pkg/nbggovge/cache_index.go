@@ -0,0 +1,155 @@
+package nbggovge
+
+import (
+	"slices"
+	"strings"
+)
+
+// cacheIndex holds currency codes of cached entries by index group and key,
+// so request could be answered by any cached entry with superset of requested currencies.
+// Index group is a date of rates along with language of currency names, see indexGroup.
+type cacheIndex map[string]map[string][]string
+
+// indexGroup returns index group of entries of date and language.
+func indexGroup(date, lang string) string {
+	if lang == "" {
+		return date
+	}
+
+	return date + "/" + lang
+}
+
+func (idx cacheIndex) add(group, key string, codes []string) {
+	// Entry of another request could be replaced by the same key.
+	idx.remove(key)
+
+	if idx[group] == nil {
+		idx[group] = make(map[string][]string)
+	}
+
+	idx[group][key] = codes
+}
+
+func (idx cacheIndex) remove(key string) {
+	for group, keys := range idx {
+		delete(keys, key)
+
+		if len(keys) == 0 {
+			delete(idx, group)
+		}
+	}
+}
+
+// supersets returns keys of entries of index group that contain all codes.
+func (idx cacheIndex) supersets(group string, codes []string) []string {
+	var keys []string
+
+	for key, entryCodes := range idx[group] {
+		if covers(entryCodes, codes) {
+			keys = append(keys, key)
+		}
+	}
+
+	// Map iteration order is random, sort to make lookups deterministic.
+	slices.Sort(keys)
+
+	return keys
+}
+
+// loadStore indexes entries already in the store, e.g. persisted by previous program run,
+// and tracks their recency by the time they were stored.
+func (c *CachedClient) loadStore() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	type stored struct {
+		key   string
+		entry CacheEntry
+	}
+
+	var entries []stored
+
+	for _, key := range c.store.Keys() {
+		if e, ok := c.store.Get(key); ok {
+			entries = append(entries, stored{key: key, entry: e})
+		}
+	}
+
+	slices.SortFunc(entries, func(a, b stored) int {
+		return a.entry.Timestamp.Compare(b.entry.Timestamp)
+	})
+
+	for _, e := range entries {
+		if e.entry.Date != "" {
+			c.index.add(indexGroup(e.entry.Date, e.entry.Language), e.key, e.entry.Codes)
+		}
+
+		c.evict(c.lru.add(e.key))
+	}
+}
+
+// covers reports whether entry with entryCodes contains all codes. Empty codes mean all currencies.
+func covers(entryCodes, codes []string) bool {
+	if len(entryCodes) == 0 {
+		return true
+	}
+
+	if len(codes) == 0 {
+		return false
+	}
+
+	for _, code := range codes {
+		if !slices.ContainsFunc(entryCodes, func(ec string) bool {
+			return strings.EqualFold(ec, code)
+		}) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// filterRates returns rates of codes only. Empty codes mean all currencies.
+func filterRates(r Rates, codes []string) Rates {
+	if len(codes) == 0 {
+		return r
+	}
+
+	filtered := Rates{
+		Date:       r.Date,
+		Currencies: make([]Currency, 0, len(codes)),
+	}
+
+	for _, c := range r.Currencies {
+		if slices.ContainsFunc(codes, func(code string) bool {
+			return strings.EqualFold(c.Code, code)
+		}) {
+			filtered.Currencies = append(filtered.Currencies, c)
+		}
+	}
+
+	return filtered
+}
+
+// preferFullDay reports whether rates of all currencies should be fetched instead of codes.
+// Rates of several currencies are fetched with one request of the full day,
+// that answers any later request of the same date.
+func preferFullDay(codes []string) bool {
+	var n int
+
+	for _, code := range codes {
+		if !strings.EqualFold(code, gelCode) {
+			n++
+		}
+	}
+
+	return n > 1
+}
+
+// sortedCodes returns sorted copy of codes.
+func sortedCodes(codes []string) []string {
+	sorted := slices.Clone(codes)
+	slices.Sort(sorted)
+
+	return sorted
+}
@@ -0,0 +1,173 @@
+package nbggovge
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/obalunenko/georgia-tax-calculator/pkg/nbggovge/option"
+)
+
+func allRates() Rates {
+	return Rates{
+		Date: "2023-12-01T00:00:00.000Z",
+		Currencies: []Currency{
+			{Code: "EUR", Quantity: 1, Rate: 2.9},
+			{Code: "GBP", Quantity: 1, Rate: 3.4},
+			{Code: "GEL", Quantity: 1, Rate: 1},
+			{Code: "USD", Quantity: 1, Rate: 2.7},
+		},
+	}
+}
+
+func ratesOf(codes ...string) Rates {
+	r := Rates{Date: allRates().Date}
+
+	for _, c := range allRates().Currencies {
+		for _, code := range codes {
+			if c.Code == code {
+				r.Currencies = append(r.Currencies, c)
+			}
+		}
+	}
+
+	return r
+}
+
+func TestCachedClient_Rates_Superset(t *testing.T) {
+	date := time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC)
+	ctx := context.Background()
+
+	mockCli := &mockClient{returnRates: allRates()}
+	cachedClient := NewCachedClient(mockCli, time.Hour)
+
+	// Full day is cached.
+	_, err := cachedClient.Rates(ctx, option.WithDate(date))
+	require.NoError(t, err)
+
+	rates, err := cachedClient.Rates(ctx, option.WithDate(date), option.WithCurrency("USD"), option.WithCurrency("GEL"))
+	require.NoError(t, err)
+	assert.Equal(t, ratesOf("GEL", "USD"), rates)
+	assert.Equal(t, 1, mockCli.callCount)
+
+	// Other dates are not answered by the entry.
+	_, err = cachedClient.Rates(ctx, option.WithDate(date.AddDate(0, 0, 1)), option.WithCurrency("USD"))
+	require.NoError(t, err)
+	assert.Equal(t, 2, mockCli.callCount)
+}
+
+func TestCachedClient_Rates_SubsetOfCodes(t *testing.T) {
+	date := time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC)
+	ctx := context.Background()
+
+	mockCli := &mockClient{returnRates: ratesOf("GEL", "USD")}
+	cachedClient := NewCachedClient(mockCli, time.Hour)
+
+	_, err := cachedClient.Rates(ctx, option.WithDate(date), option.WithCurrency("USD"), option.WithCurrency("GEL"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"USD", "GEL"}, mockCli.lastParams.CurrencyCodes)
+
+	rates, err := cachedClient.Rates(ctx, option.WithDate(date), option.WithCurrency("USD"))
+	require.NoError(t, err)
+	assert.Equal(t, ratesOf("USD"), rates)
+	assert.Equal(t, 1, mockCli.callCount)
+
+	// EUR is not in cached entry.
+	mockCli.returnRates = ratesOf("EUR")
+
+	_, err = cachedClient.Rates(ctx, option.WithDate(date), option.WithCurrency("EUR"))
+	require.NoError(t, err)
+	assert.Equal(t, 2, mockCli.callCount)
+}
+
+func TestCachedClient_Rates_PreferFullDay(t *testing.T) {
+	date := time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC)
+	ctx := context.Background()
+
+	mockCli := &mockClient{returnRates: allRates()}
+	cachedClient := NewCachedClient(mockCli, time.Hour)
+
+	rates, err := cachedClient.Rates(ctx, option.WithDate(date), option.WithCurrency("USD"), option.WithCurrency("EUR"))
+	require.NoError(t, err)
+	assert.Equal(t, ratesOf("EUR", "USD"), rates)
+	assert.Empty(t, mockCli.lastParams.CurrencyCodes, "full day should be fetched")
+
+	rates, err = cachedClient.Rates(ctx, option.WithDate(date), option.WithCurrency("GBP"), option.WithCurrency("GEL"))
+	require.NoError(t, err)
+	assert.Equal(t, ratesOf("GBP", "GEL"), rates)
+	assert.Equal(t, 1, mockCli.callCount)
+}
+
+func TestCachedClient_Rates_SupersetPersisted(t *testing.T) {
+	date := time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC)
+	dir := filepath.Join(t.TempDir(), "rates")
+	ctx := context.Background()
+
+	_, err := NewCachedClient(&mockClient{returnRates: allRates()}, time.Hour, WithCacheDir(dir)).
+		Rates(ctx, option.WithDate(date))
+	require.NoError(t, err)
+
+	restarted := &mockClient{}
+
+	rates, err := NewCachedClient(restarted, time.Hour, WithCacheDir(dir)).
+		Rates(ctx, option.WithDate(date), option.WithCurrency("USD"))
+	require.NoError(t, err)
+	assert.Equal(t, ratesOf("USD"), rates)
+	assert.Equal(t, 0, restarted.callCount)
+}
+
+func TestCovers(t *testing.T) {
+	tests := []struct {
+		name       string
+		entryCodes []string
+		codes      []string
+		want       bool
+	}{
+		{name: "all covers all", entryCodes: nil, codes: nil, want: true},
+		{name: "all covers codes", entryCodes: nil, codes: []string{"USD"}, want: true},
+		{name: "codes do not cover all", entryCodes: []string{"USD"}, codes: nil, want: false},
+		{name: "superset", entryCodes: []string{"EUR", "GEL", "USD"}, codes: []string{"GEL", "USD"}, want: true},
+		{name: "case insensitive", entryCodes: []string{"USD"}, codes: []string{"usd"}, want: true},
+		{name: "missing code", entryCodes: []string{"EUR", "USD"}, codes: []string{"GEL", "USD"}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, covers(tt.entryCodes, tt.codes))
+		})
+	}
+}
+
+func TestCachedClient_Rates_Language(t *testing.T) {
+	mockCli := &mockClient{returnRates: allRates()}
+	cachedClient := NewCachedClient(mockCli, time.Hour)
+
+	ctx := context.Background()
+	date := option.WithDate(time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC))
+
+	_, err := cachedClient.Rates(ctx, date, option.WithCurrency("USD"), option.WithCurrency("EUR"))
+	require.NoError(t, err)
+	assert.Empty(t, mockCli.lastParams.Language)
+
+	// Rates of other language are not served from cache.
+	_, err = cachedClient.Rates(ctx, date, option.WithCurrency("USD"), option.WithLanguage(LanguageGeorgian))
+	require.NoError(t, err)
+	assert.Equal(t, 2, mockCli.callCount)
+	assert.Equal(t, LanguageGeorgian, mockCli.lastParams.Language)
+
+	// Full day of language is fetched in that language and answers later requests of it.
+	_, err = cachedClient.Rates(ctx, date, option.WithCurrency("GBP"), option.WithCurrency("EUR"),
+		option.WithLanguage(LanguageRussian))
+	require.NoError(t, err)
+	assert.Equal(t, 3, mockCli.callCount)
+	assert.Equal(t, LanguageRussian, mockCli.lastParams.Language)
+	assert.Empty(t, mockCli.lastParams.CurrencyCodes)
+
+	_, err = cachedClient.Rates(ctx, date, option.WithCurrency("USD"), option.WithLanguage(LanguageRussian))
+	require.NoError(t, err)
+	assert.Equal(t, 3, mockCli.callCount)
+}
@@ -0,0 +1,89 @@
+package nbggovge
+
+import (
+	"container/list"
+	"sync"
+)
+
+// lru tracks recency of cache keys to evict the least recently used ones when cache is full.
+type lru struct {
+	mu sync.Mutex
+	// max number of keys, 0 means unlimited.
+	max int
+	// order holds keys, the front is the most recently used.
+	order *list.List
+	items map[string]*list.Element
+}
+
+func newLRU(maxEntries int) *lru {
+	return &lru{
+		max:   maxEntries,
+		order: list.New(),
+		items: make(map[string]*list.Element),
+	}
+}
+
+// touch marks key as the most recently used.
+func (l *lru) touch(key string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if e, ok := l.items[key]; ok {
+		l.order.MoveToFront(e)
+
+		return
+	}
+
+	l.items[key] = l.order.PushFront(key)
+}
+
+// touchIfPresent marks key as the most recently used if it is still tracked, so key of entry
+// evicted after it was read is not tracked again.
+func (l *lru) touchIfPresent(key string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if e, ok := l.items[key]; ok {
+		l.order.MoveToFront(e)
+	}
+}
+
+// add marks key as the most recently used and returns least recently used keys over the limit.
+// Returned keys are removed from lru.
+func (l *lru) add(key string) []string {
+	l.touch(key)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var evicted []string
+
+	for l.max > 0 && l.order.Len() > l.max {
+		e := l.order.Back()
+
+		k, _ := l.order.Remove(e).(string)
+		delete(l.items, k)
+
+		evicted = append(evicted, k)
+	}
+
+	return evicted
+}
+
+func (l *lru) remove(key string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if e, ok := l.items[key]; ok {
+		l.order.Remove(e)
+		delete(l.items, key)
+	}
+}
+
+func (l *lru) clear() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.order.Init()
+	clear(l.items)
+}
@@ -0,0 +1,138 @@
+package nbggovge
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/obalunenko/georgia-tax-calculator/pkg/nbggovge/option"
+)
+
+func TestLRU(t *testing.T) {
+	l := newLRU(2)
+
+	assert.Empty(t, l.add("a"))
+	assert.Empty(t, l.add("b"))
+
+	// a becomes the most recently used, so b is evicted.
+	l.touch("a")
+	assert.Equal(t, []string{"b"}, l.add("c"))
+
+	l.remove("a")
+	assert.Empty(t, l.add("d"))
+	assert.Equal(t, []string{"c"}, l.add("e"))
+
+	// Evicted c is not tracked again after it was read.
+	l.touchIfPresent("c")
+	l.touchIfPresent("d")
+	assert.Equal(t, []string{"e"}, l.add("f"))
+
+	l.clear()
+	assert.Empty(t, l.add("f"))
+
+	unlimited := newLRU(0)
+
+	for _, k := range []string{"a", "b", "c"} {
+		assert.Empty(t, unlimited.add(k))
+	}
+}
+
+func TestCachedClient_MaxEntries(t *testing.T) {
+	ctx := context.Background()
+	day := func(d int) option.RatesOption {
+		return option.WithDate(time.Date(2023, 12, d, 0, 0, 0, 0, time.UTC))
+	}
+
+	mockCli := &mockClient{returnRates: Rates{Date: "2023-12-01"}}
+	cachedClient := NewCachedClient(mockCli, time.Hour, WithMaxEntries(2))
+
+	for _, d := range []int{1, 2} {
+		_, err := cachedClient.Rates(ctx, day(d))
+		require.NoError(t, err)
+	}
+
+	// Day 1 becomes the most recently used, so day 2 is evicted by day 3.
+	_, err := cachedClient.Rates(ctx, day(1))
+	require.NoError(t, err)
+
+	_, err = cachedClient.Rates(ctx, day(3))
+	require.NoError(t, err)
+	assert.Equal(t, 3, mockCli.callCount)
+
+	_, err = cachedClient.Rates(ctx, day(1))
+	require.NoError(t, err)
+	assert.Equal(t, 3, mockCli.callCount)
+
+	_, err = cachedClient.Rates(ctx, day(2))
+	require.NoError(t, err)
+	assert.Equal(t, 4, mockCli.callCount)
+
+	stats := cachedClient.GetCacheStats()
+	assert.Equal(t, 2, stats.TotalEntries)
+	assert.Equal(t, int64(2), stats.Hits)
+	assert.Equal(t, int64(4), stats.Misses)
+	assert.Equal(t, int64(2), stats.Evictions)
+	assert.Equal(t, int64(4), stats.UpstreamCalls)
+	assert.Equal(t, stats.UpstreamLatency/4, stats.AvgUpstreamLatency())
+}
+
+func TestCachedClient_MaxEntries_Persisted(t *testing.T) {
+	ctx := context.Background()
+	dir := filepath.Join(t.TempDir(), "rates")
+
+	mockCli := &mockClient{returnRates: Rates{Date: "2023-12-01"}}
+	cachedClient := NewCachedClient(mockCli, time.Hour, WithCacheDir(dir))
+
+	for _, d := range []int{1, 2, 3} {
+		_, err := cachedClient.Rates(ctx, option.WithDate(time.Date(2023, 12, d, 0, 0, 0, 0, time.UTC)))
+		require.NoError(t, err)
+	}
+
+	// Entries over the limit are evicted on the first request, the oldest first.
+	restarted := &mockClient{returnRates: Rates{Date: "2023-12-01"}}
+	cachedClient = NewCachedClient(restarted, time.Hour, WithCacheDir(dir), WithMaxEntries(2))
+
+	_, err := cachedClient.Rates(ctx, option.WithDate(time.Date(2023, 12, 3, 0, 0, 0, 0, time.UTC)))
+	require.NoError(t, err)
+	assert.Equal(t, 0, restarted.callCount)
+
+	stats := cachedClient.GetCacheStats()
+	assert.Equal(t, 2, stats.TotalEntries)
+	assert.Equal(t, int64(1), stats.Evictions)
+
+	_, err = cachedClient.Rates(ctx, option.WithDate(time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC)))
+	require.NoError(t, err)
+	assert.Equal(t, 1, restarted.callCount)
+}
+
+func TestCachedClient_StartJanitor(t *testing.T) {
+	mockCli := &mockClient{returnRates: Rates{}}
+	cachedClient := NewCachedClient(mockCli, time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Rates of today follow TTL, so they expire.
+	_, err := cachedClient.Rates(ctx, option.WithDate(time.Now()))
+	require.NoError(t, err)
+
+	cachedClient.StartJanitor(ctx, time.Millisecond*5)
+
+	assert.Eventually(t, func() bool {
+		return cachedClient.GetCacheStats().TotalEntries == 0
+	}, time.Second, time.Millisecond*5)
+}
+
+func TestCachedClient_StartJanitor_NotPositiveInterval(t *testing.T) {
+	cachedClient := NewCachedClient(&mockClient{}, time.Millisecond)
+
+	for _, interval := range []time.Duration{0, -time.Second} {
+		assert.NotPanics(t, func() {
+			cachedClient.StartJanitor(context.Background(), interval)
+		})
+	}
+}
@@ -0,0 +1,248 @@
+package nbggovge
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/obalunenko/georgia-tax-calculator/pkg/nbggovge/internal"
+)
+
+// CacheEntry is a cached rates with the time they were stored.
+type CacheEntry struct {
+	Rates     Rates     `json:"rates"`
+	Timestamp time.Time `json:"timestamp"`
+	// Permanent entries never expire. Rates of past dates never change, so they are stored permanently.
+	Permanent bool `json:"permanent"`
+	// Date of rates in YYYY-MM-DD format.
+	Date string `json:"date"`
+	// Codes are sorted currency codes of request. Empty codes mean all currencies.
+	Codes []string `json:"codes,omitempty"`
+	// Language of currency names. Empty language means default language of client.
+	Language string `json:"language,omitempty"`
+}
+
+// requested reports whether entry stores rates of request with params. Keys are short hashes of params,
+// so entry of another request could be stored by the same key. Entries stored before params were
+// saved along with rates could not be checked, so they are fetched again.
+func (e CacheEntry) requested(params internal.RatesParams) bool {
+	return e.Date == params.Date.Format(time.DateOnly) &&
+		slices.Equal(e.Codes, params.CurrencyCodes) &&
+		e.Language == params.Language
+}
+
+// CacheStore is a storage backend of CachedClient.
+// Access to the store is synchronized by CachedClient: Get could be called concurrently,
+// while other methods are called exclusively.
+type CacheStore interface {
+	// Get returns entry stored by key. ok is false when there is no valid entry.
+	Get(key string) (entry CacheEntry, ok bool)
+	// Set stores entry by key.
+	Set(key string, entry CacheEntry) error
+	// Delete removes entry by key.
+	Delete(key string) error
+	// Keys returns keys of all stored entries.
+	Keys() []string
+	// Clear removes all entries.
+	Clear() error
+}
+
+// CacheOption configures CachedClient.
+type CacheOption func(c *CachedClient)
+
+// WithCacheDir stores cached rates in files in dir, so they survive program restarts.
+// Directory is created on first write when it does not exist.
+func WithCacheDir(dir string) CacheOption {
+	return WithCacheStore(NewFileCacheStore(dir))
+}
+
+// WithNegativeTTL sets time for which upstream failures are cached, so repeated requests
+// fail fast instead of calling the API again. Zero ttl disables negative caching.
+// Default is DefaultNegativeTTL.
+func WithNegativeTTL(ttl time.Duration) CacheOption {
+	return func(c *CachedClient) {
+		c.negativeTTL = ttl
+	}
+}
+
+// WithMaxEntries limits number of cached entries. When cache is full,
+// the least recently used entries are evicted. Zero n means no limit, that is the default.
+func WithMaxEntries(n int) CacheOption {
+	return func(c *CachedClient) {
+		c.lru.max = n
+	}
+}
+
+// WithCacheStore sets storage backend of CachedClient. By default, rates are cached in memory.
+func WithCacheStore(store CacheStore) CacheOption {
+	return func(c *CachedClient) {
+		c.store = store
+	}
+}
+
+// memoryStore keeps entries in a map.
+type memoryStore map[string]CacheEntry
+
+// NewMemoryCacheStore returns CacheStore that keeps entries in memory until program restart.
+func NewMemoryCacheStore() CacheStore {
+	return make(memoryStore)
+}
+
+func (m memoryStore) Get(key string) (CacheEntry, bool) {
+	e, ok := m[key]
+
+	return e, ok
+}
+
+func (m memoryStore) Set(key string, entry CacheEntry) error {
+	m[key] = entry
+
+	return nil
+}
+
+func (m memoryStore) Delete(key string) error {
+	delete(m, key)
+
+	return nil
+}
+
+func (m memoryStore) Keys() []string {
+	keys := make([]string, 0, len(m))
+
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	return keys
+}
+
+func (m memoryStore) Clear() error {
+	clear(m)
+
+	return nil
+}
+
+// fileStore keeps each entry in a separate JSON file in dir.
+type fileStore struct {
+	dir string
+}
+
+const (
+	cacheFileExt         = ".json"
+	cacheDirPerm         = 0o750
+	cacheFilePerm        = 0o600
+	cacheTempFilePattern = "*.tmp"
+)
+
+// NewFileCacheStore returns CacheStore that keeps entries in files in dir.
+func NewFileCacheStore(dir string) CacheStore {
+	return fileStore{dir: dir}
+}
+
+func (f fileStore) path(key string) string {
+	return filepath.Join(f.dir, key+cacheFileExt)
+}
+
+// Get returns entry stored in file. Missing and corrupted files are reported as absent entries.
+func (f fileStore) Get(key string) (CacheEntry, bool) {
+	data, err := os.ReadFile(f.path(key))
+	if err != nil {
+		return CacheEntry{}, false
+	}
+
+	var e CacheEntry
+
+	if err = json.Unmarshal(data, &e); err != nil {
+		return CacheEntry{}, false
+	}
+
+	return e, true
+}
+
+// Set writes entry to a temporary file and renames it, so readers never see partially written entries.
+func (f fileStore) Set(key string, entry CacheEntry) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("marshal cache entry: %w", err)
+	}
+
+	if err = os.MkdirAll(f.dir, cacheDirPerm); err != nil {
+		return fmt.Errorf("create cache dir: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(f.dir, key+cacheTempFilePattern)
+	if err != nil {
+		return fmt.Errorf("create cache file: %w", err)
+	}
+
+	defer func() {
+		_ = os.Remove(tmp.Name())
+	}()
+
+	if _, err = tmp.Write(data); err != nil {
+		_ = tmp.Close()
+
+		return fmt.Errorf("write cache file: %w", err)
+	}
+
+	if err = tmp.Chmod(cacheFilePerm); err != nil {
+		_ = tmp.Close()
+
+		return fmt.Errorf("chmod cache file: %w", err)
+	}
+
+	if err = tmp.Close(); err != nil {
+		return fmt.Errorf("close cache file: %w", err)
+	}
+
+	if err = os.Rename(tmp.Name(), f.path(key)); err != nil {
+		return fmt.Errorf("rename cache file: %w", err)
+	}
+
+	return nil
+}
+
+func (f fileStore) Delete(key string) error {
+	if err := os.Remove(f.path(key)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("remove cache file: %w", err)
+	}
+
+	return nil
+}
+
+func (f fileStore) Keys() []string {
+	entries, err := os.ReadDir(f.dir)
+	if err != nil {
+		return nil
+	}
+
+	keys := make([]string, 0, len(entries))
+
+	for _, e := range entries {
+		name := e.Name()
+
+		if e.IsDir() || !strings.HasSuffix(name, cacheFileExt) {
+			continue
+		}
+
+		keys = append(keys, strings.TrimSuffix(name, cacheFileExt))
+	}
+
+	return keys
+}
+
+func (f fileStore) Clear() error {
+	var errs []error
+
+	for _, key := range f.Keys() {
+		errs = append(errs, f.Delete(key))
+	}
+
+	return errors.Join(errs...)
+}
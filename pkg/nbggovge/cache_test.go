@@ -2,24 +2,37 @@
 
 import (
 	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/obalunenko/georgia-tax-calculator/pkg/nbggovge/internal"
 	"github.com/obalunenko/georgia-tax-calculator/pkg/nbggovge/option"
 )
 
 // mockClient is a simple mock implementation of the Client interface
 type mockClient struct {
 	callCount   int
+	lastParams  internal.RatesParams
 	returnRates Rates
 	returnError error
 }
 
 func (m *mockClient) Rates(ctx context.Context, opts ...option.RatesOption) (Rates, error) {
 	m.callCount++
+
+	m.lastParams = internal.RatesParams{}
+	for _, opt := range opts {
+		opt.Apply(&m.lastParams)
+	}
+
 	return m.returnRates, m.returnError
 }
 
@@ -32,7 +45,7 @@ func TestNewCachedClient(t *testing.T) {
 	assert.NotNil(t, cachedClient)
 	assert.Equal(t, mockCli, cachedClient.client)
 	assert.Equal(t, ttl, cachedClient.ttl)
-	assert.NotNil(t, cachedClient.cache)
+	assert.NotNil(t, cachedClient.store)
 }
 
 func TestNewCachedClientWithDefaultTTL(t *testing.T) {
@@ -43,7 +56,7 @@ func TestNewCachedClientWithDefaultTTL(t *testing.T) {
 	assert.NotNil(t, cachedClient)
 	assert.Equal(t, mockCli, cachedClient.client)
 	assert.Equal(t, time.Hour, cachedClient.ttl)
-	assert.NotNil(t, cachedClient.cache)
+	assert.NotNil(t, cachedClient.store)
 }
 
 func TestCachedClient_Rates_CacheMiss(t *testing.T) {
@@ -116,7 +129,8 @@ func TestCachedClient_Rates_CacheExpired(t *testing.T) {
 	cachedClient := NewCachedClient(mockCli, time.Millisecond)
 
 	ctx := context.Background()
-	opts := []option.RatesOption{option.WithDate(time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC))}
+	// Rates of today follow TTL, past dates are cached permanently.
+	opts := []option.RatesOption{option.WithDate(time.Now())}
 
 	// First call should hit the API
 	rates1, err := cachedClient.Rates(ctx, opts...)
@@ -164,21 +178,53 @@ func TestCachedClient_GenerateCacheKey(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			key := cachedClient.generateCacheKey(date, tt.currencyCodes)
+			key := cachedClient.generateCacheKey(date, tt.currencyCodes, "")
 			assert.Len(t, key, tt.expectedLength)
 		})
 	}
 
 	// Test that same parameters produce same key
-	key1 := cachedClient.generateCacheKey(date, []string{"USD", "EUR"})
-	key2 := cachedClient.generateCacheKey(date, []string{"EUR", "USD"}) // Different order
+	key1 := cachedClient.generateCacheKey(date, []string{"USD", "EUR"}, "")
+	key2 := cachedClient.generateCacheKey(date, []string{"EUR", "USD"}, "") // Different order
 	assert.Equal(t, key1, key2, "Cache keys should be same regardless of currency order")
 
 	// Test that different dates produce different keys
 	date2 := time.Date(2023, 12, 2, 0, 0, 0, 0, time.UTC)
-	key3 := cachedClient.generateCacheKey(date2, []string{"USD"})
-	key4 := cachedClient.generateCacheKey(date, []string{"USD"})
+	key3 := cachedClient.generateCacheKey(date2, []string{"USD"}, "")
+	key4 := cachedClient.generateCacheKey(date, []string{"USD"}, "")
 	assert.NotEqual(t, key3, key4, "Different dates should produce different cache keys")
+
+	// Test that different languages produce different keys
+	key5 := cachedClient.generateCacheKey(date, []string{"USD"}, "ka")
+	assert.NotEqual(t, key4, key5, "Different languages should produce different cache keys")
+}
+
+func TestCachedClient_Rates_KeyCollision(t *testing.T) {
+	mockCli := &mockClient{returnRates: Rates{Date: "2023-12-01"}}
+	cachedClient := NewCachedClient(mockCli, time.Hour)
+
+	date := time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC)
+	key := cachedClient.generateCacheKey(date, []string{"USD"}, "")
+
+	// Entry of another request stored by the same key.
+	require.NoError(t, cachedClient.store.Set(key, CacheEntry{
+		Rates:     Rates{Date: "2021-03-04"},
+		Timestamp: time.Now(),
+		Permanent: true,
+		Date:      "2021-03-04",
+		Codes:     []string{"EUR"},
+	}))
+
+	rates, err := cachedClient.Rates(context.Background(), option.WithDate(date), option.WithCurrency("USD"))
+	require.NoError(t, err)
+	assert.Equal(t, mockCli.returnRates, rates)
+	assert.Equal(t, 1, mockCli.callCount)
+
+	// Rates of the request replace the entry of another one.
+	rates, err = cachedClient.Rates(context.Background(), option.WithDate(date), option.WithCurrency("USD"))
+	require.NoError(t, err)
+	assert.Equal(t, mockCli.returnRates, rates)
+	assert.Equal(t, 1, mockCli.callCount)
 }
 
 func TestCachedClient_ClearCache(t *testing.T) {
@@ -235,7 +281,8 @@ func TestCachedClient_ClearExpired(t *testing.T) {
 	cachedClient := NewCachedClient(mockCli, time.Millisecond)
 
 	ctx := context.Background()
-	opts := []option.RatesOption{option.WithDate(time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC))}
+	// Rates of today follow TTL, past dates are cached permanently.
+	opts := []option.RatesOption{option.WithDate(time.Now())}
 
 	// Populate cache
 	_, err := cachedClient.Rates(ctx, opts...)
@@ -276,7 +323,8 @@ func TestCachedClient_GetCacheStats(t *testing.T) {
 	mockCli.returnError = nil
 
 	ctx := context.Background()
-	opts := []option.RatesOption{option.WithDate(time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC))}
+	// Rates of today follow TTL, past dates are cached permanently.
+	opts := []option.RatesOption{option.WithDate(time.Now())}
 
 	// Populate cache
 	_, err := cachedClient.Rates(ctx, opts...)
@@ -335,3 +383,258 @@ func TestCachedClient_NoTTL(t *testing.T) {
 	assert.Equal(t, 1, stats.TotalEntries)
 	assert.Equal(t, 0, stats.ExpiredEntries)
 }
+
+func TestCachedClient_Rates_PastDatePermanent(t *testing.T) {
+	mockCli := &mockClient{
+		returnRates: Rates{Date: "2023-12-01"},
+	}
+	cachedClient := NewCachedClient(mockCli, time.Millisecond)
+
+	ctx := context.Background()
+	opts := []option.RatesOption{option.WithDate(time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC))}
+
+	_, err := cachedClient.Rates(ctx, opts...)
+	require.NoError(t, err)
+
+	time.Sleep(time.Millisecond * 10)
+
+	_, err = cachedClient.Rates(ctx, opts...)
+	require.NoError(t, err)
+	assert.Equal(t, 1, mockCli.callCount)
+
+	cachedClient.ClearExpired()
+
+	stats := cachedClient.GetCacheStats()
+	assert.Equal(t, 1, stats.TotalEntries)
+	assert.Equal(t, 0, stats.ExpiredEntries)
+}
+
+func TestCachedClient_WithCacheDir(t *testing.T) {
+	expectedRates := Rates{
+		Date: "2023-12-01",
+		Currencies: []Currency{
+			{Code: "USD", Rate: 2.7, Name: "US Dollar"},
+		},
+	}
+
+	dir := filepath.Join(t.TempDir(), "rates")
+	ctx := context.Background()
+	opts := []option.RatesOption{option.WithDate(time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC))}
+
+	mockCli := &mockClient{returnRates: expectedRates}
+
+	_, err := NewCachedClient(mockCli, time.Hour, WithCacheDir(dir)).Rates(ctx, opts...)
+	require.NoError(t, err)
+	assert.Equal(t, 1, mockCli.callCount)
+
+	// New client with the same directory reads rates stored by the previous one.
+	restarted := &mockClient{}
+	cachedClient := NewCachedClient(restarted, time.Hour, WithCacheDir(dir))
+
+	rates, err := cachedClient.Rates(ctx, opts...)
+	require.NoError(t, err)
+	assert.Equal(t, expectedRates, rates)
+	assert.Equal(t, 0, restarted.callCount)
+
+	cachedClient.ClearCache()
+
+	assert.Equal(t, 0, cachedClient.GetCacheStats().TotalEntries)
+}
+
+func TestFileCacheStore(t *testing.T) {
+	dir := t.TempDir()
+	store := NewFileCacheStore(dir)
+
+	_, ok := store.Get("missing")
+	assert.False(t, ok)
+
+	entry := CacheEntry{
+		Rates:     Rates{Date: "2023-12-01"},
+		Timestamp: time.Date(2023, 12, 1, 10, 0, 0, 0, time.UTC),
+		Permanent: true,
+	}
+
+	require.NoError(t, store.Set("key", entry))
+
+	got, ok := store.Get("key")
+	require.True(t, ok)
+	assert.Equal(t, entry, got)
+	assert.Equal(t, []string{"key"}, store.Keys())
+
+	// Corrupted entries are treated as missing.
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "broken.json"), []byte("{"), 0o600))
+
+	_, ok = store.Get("broken")
+	assert.False(t, ok)
+
+	require.NoError(t, store.Delete("key"))
+	require.NoError(t, store.Delete("key"))
+	require.NoError(t, store.Clear())
+	assert.Empty(t, store.Keys())
+}
+
+// blockingClient is a mock client that blocks until release is closed or context is done.
+type blockingClient struct {
+	calls   atomic.Int32
+	release chan struct{}
+	rates   Rates
+}
+
+func (b *blockingClient) Rates(ctx context.Context, _ ...option.RatesOption) (Rates, error) {
+	b.calls.Add(1)
+
+	select {
+	case <-ctx.Done():
+		return Rates{}, ctx.Err()
+	case <-b.release:
+		return b.rates, nil
+	}
+}
+
+func TestCachedClient_Rates_Coalescing(t *testing.T) {
+	mockCli := &blockingClient{
+		release: make(chan struct{}),
+		rates:   Rates{Date: "2023-12-01"},
+	}
+	cachedClient := NewCachedClient(mockCli, time.Hour)
+
+	opts := []option.RatesOption{option.WithDate(time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC))}
+
+	const callers = 10
+
+	var wg sync.WaitGroup
+
+	results := make([]Rates, callers)
+	errs := make([]error, callers)
+
+	for i := range callers {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			results[i], errs[i] = cachedClient.Rates(context.Background(), opts...)
+		}()
+	}
+
+	// Let all callers join the in-flight fetch.
+	time.Sleep(time.Millisecond * 50)
+	close(mockCli.release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), mockCli.calls.Load())
+
+	for i := range callers {
+		require.NoError(t, errs[i])
+		assert.Equal(t, mockCli.rates, results[i])
+	}
+}
+
+func TestCachedClient_Rates_LeaderCanceled(t *testing.T) {
+	mockCli := &blockingClient{
+		release: make(chan struct{}),
+		rates:   Rates{Date: "2023-12-01"},
+	}
+	cachedClient := NewCachedClient(mockCli, time.Hour)
+
+	opts := []option.RatesOption{option.WithDate(time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC))}
+
+	leaderCtx, cancel := context.WithCancel(context.Background())
+
+	leaderErr := make(chan error)
+
+	go func() {
+		_, err := cachedClient.Rates(leaderCtx, opts...)
+		leaderErr <- err
+	}()
+
+	time.Sleep(time.Millisecond * 20)
+
+	type result struct {
+		rates Rates
+		err   error
+	}
+
+	follower := make(chan result)
+
+	go func() {
+		rates, err := cachedClient.Rates(context.Background(), opts...)
+		follower <- result{rates: rates, err: err}
+	}()
+
+	time.Sleep(time.Millisecond * 20)
+	cancel()
+
+	require.ErrorIs(t, <-leaderErr, context.Canceled)
+
+	// Follower is not affected by canceled context of the leader and fetches rates again.
+	time.Sleep(time.Millisecond * 20)
+	close(mockCli.release)
+
+	res := <-follower
+	require.NoError(t, res.err)
+	assert.Equal(t, mockCli.rates, res.rates)
+	assert.Equal(t, int32(2), mockCli.calls.Load())
+}
+
+func TestCachedClient_Rates_NegativeCache(t *testing.T) {
+	errUpstream := errors.New("upstream failure")
+
+	mockCli := &mockClient{returnError: errUpstream}
+	cachedClient := NewCachedClient(mockCli, time.Hour, WithNegativeTTL(time.Millisecond*20))
+
+	ctx := context.Background()
+	opts := []option.RatesOption{option.WithDate(time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC))}
+
+	_, err := cachedClient.Rates(ctx, opts...)
+	require.ErrorIs(t, err, errUpstream)
+
+	// Failure is cached, so API is not called again.
+	_, err = cachedClient.Rates(ctx, opts...)
+	require.ErrorIs(t, err, errUpstream)
+	assert.Equal(t, 1, mockCli.callCount)
+
+	time.Sleep(time.Millisecond * 30)
+
+	mockCli.returnError = nil
+	mockCli.returnRates = Rates{Date: "2023-12-01"}
+
+	rates, err := cachedClient.Rates(ctx, opts...)
+	require.NoError(t, err)
+	assert.Equal(t, mockCli.returnRates, rates)
+	assert.Equal(t, 2, mockCli.callCount)
+	assert.Empty(t, cachedClient.failures)
+}
+
+func TestCachedClient_ClearExpired_Failures(t *testing.T) {
+	mockCli := &mockClient{returnError: errors.New("upstream failure")}
+	cachedClient := NewCachedClient(mockCli, 0, WithNegativeTTL(time.Millisecond*20))
+
+	for _, day := range []int{1, 2} {
+		_, err := cachedClient.Rates(context.Background(), option.WithDate(time.Date(2023, 12, day, 0, 0, 0, 0, time.UTC)))
+		require.Error(t, err)
+	}
+
+	cachedClient.ClearExpired()
+	assert.Len(t, cachedClient.failures, 2)
+
+	time.Sleep(time.Millisecond * 30)
+
+	// Failures expire regardless of TTL of cached rates.
+	cachedClient.ClearExpired()
+	assert.Empty(t, cachedClient.failures)
+}
+
+func TestCachedClient_Rates_NegativeCacheDisabled(t *testing.T) {
+	mockCli := &mockClient{returnError: errors.New("upstream failure")}
+	cachedClient := NewCachedClient(mockCli, time.Hour, WithNegativeTTL(0))
+
+	opts := []option.RatesOption{option.WithDate(time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC))}
+
+	for range 3 {
+		_, err := cachedClient.Rates(context.Background(), opts...)
+		require.Error(t, err)
+	}
+
+	assert.Equal(t, 3, mockCli.callCount)
+}
@@ -23,54 +23,110 @@ type Client interface {
 	Rates(ctx context.Context, opts ...option.RatesOption) (Rates, error)
 }
 
+// RangeClient is a Client that returns history of rates, see RatesRange.
+type RangeClient interface {
+	Client
+	// RatesRange returns history of rates from one date to another inclusive for a list of currency codes
+	// set up by options. Dates without published rates, as weekends and holidays, are skipped.
+	RatesRange(ctx context.Context, from, to time.Time, opts ...option.RatesOption) (RatesHistory, error)
+}
+
 // HTTPClient is and interface for mocking sending http requests.
 type HTTPClient interface {
 	Do(req *http.Request) (*http.Response, error)
 }
 
 // New returns nbg.gov.ge API client.
-func New() Client {
-	return NewWithHTTPClient(http.DefaultClient)
+// Failed requests are retried with exponential backoff, see ClientOption to configure retries and rate limit.
+func New(opts ...ClientOption) Client {
+	return NewWithHTTPClient(http.DefaultClient, opts...)
 }
 
 // NewWithHTTPClient returns nbg.gov.ge API client with specified http client.
-func NewWithHTTPClient(c HTTPClient) Client {
-	return client{
+func NewWithHTTPClient(c HTTPClient, opts ...ClientOption) Client {
+	cli := client{
 		HTTPClient: c,
+		retry:      defaultRetryPolicy(),
+		baseURL:    DefaultBaseURL,
+		language:   LanguageEnglish,
+	}
+
+	for _, opt := range opts {
+		opt(&cli)
 	}
+
+	return cli
 }
 
 // NewCached returns a cached nbg.gov.ge API client with default TTL of 1 hour.
 // This is a convenience function that wraps the regular client with caching functionality.
-func NewCached() *CachedClient {
-	return NewCachedClientWithDefaultTTL(New())
+func NewCached(opts ...CacheOption) *CachedClient {
+	return NewCachedClientWithDefaultTTL(New(), opts...)
 }
 
 // NewCachedWithTTL returns a cached nbg.gov.ge API client with specified TTL.
 // This is a convenience function that wraps the regular client with caching functionality.
-func NewCachedWithTTL(ttl time.Duration) *CachedClient {
-	return NewCachedClient(New(), ttl)
+func NewCachedWithTTL(ttl time.Duration, opts ...CacheOption) *CachedClient {
+	return NewCachedClient(New(), ttl, opts...)
 }
 
 // NewCachedWithHTTPClient returns a cached nbg.gov.ge API client with specified HTTP client and default TTL.
-func NewCachedWithHTTPClient(c HTTPClient) *CachedClient {
-	return NewCachedClientWithDefaultTTL(NewWithHTTPClient(c))
+func NewCachedWithHTTPClient(c HTTPClient, opts ...CacheOption) *CachedClient {
+	return NewCachedClientWithDefaultTTL(NewWithHTTPClient(c), opts...)
 }
 
 // NewCachedWithHTTPClientAndTTL returns a cached nbg.gov.ge API client with specified HTTP client and TTL.
-func NewCachedWithHTTPClientAndTTL(c HTTPClient, ttl time.Duration) *CachedClient {
-	return NewCachedClient(NewWithHTTPClient(c), ttl)
+func NewCachedWithHTTPClientAndTTL(c HTTPClient, ttl time.Duration, opts ...CacheOption) *CachedClient {
+	return NewCachedClient(NewWithHTTPClient(c), ttl, opts...)
+}
+
+// WithBaseURL sets URL of the API, e.g. of a local mirror or a test server.
+// Rates are requested from <baseURL>/<language>/json. Default is DefaultBaseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *client) {
+		c.baseURL = baseURL
+	}
+}
+
+// WithLanguage sets language of currency names, one of LanguageEnglish, LanguageGeorgian, LanguageRussian.
+// It could be overridden for a request with option.WithLanguage. Default is LanguageEnglish.
+func WithLanguage(lang string) ClientOption {
+	return func(c *client) {
+		c.language = lang
+	}
+}
+
+// WithUserAgent sets User-Agent header of requests. By default, User-Agent of HTTP client is used.
+func WithUserAgent(userAgent string) ClientOption {
+	return func(c *client) {
+		c.userAgent = userAgent
+	}
 }
 
 type client struct {
 	HTTPClient
+	retry     retryPolicy
+	limiter   *limiter
+	baseURL   string
+	language  string
+	userAgent string
 }
 
+// DefaultBaseURL is a URL of nbg.gov.ge currencies API.
+const DefaultBaseURL = "https://nbg.gov.ge/gw/api/ct/monetarypolicy/currencies"
+
+// Languages of currency names supported by API.
+const (
+	LanguageEnglish  = "en"
+	LanguageGeorgian = "ka"
+	LanguageRussian  = "ru"
+)
+
 const (
-	basePath        = "https://nbg.gov.ge/gw/api/ct/monetarypolicy/currencies/en/json"
 	currenciesParam = internal.CurrencyCodesParam
 	dateParam       = internal.DateParam
 	dateLayout      = internal.DateLayout
+	gelCode         = "GEL"
 )
 
 // Rates fetches rates, list of currencies and date could be set by optional option.RatesOption.
@@ -86,11 +142,19 @@ func (c client) Rates(ctx context.Context, opts ...option.RatesOption) (Rates, e
 		params.Date = time.Now()
 	}
 
-	u, err := url.Parse(basePath)
+	lang := cmp.Or(params.Language, c.language)
+
+	if !slices.Contains([]string{LanguageEnglish, LanguageGeorgian, LanguageRussian}, lang) {
+		return Rates{}, fmt.Errorf("%q: %w", lang, ErrUnsupportedLanguage)
+	}
+
+	u, err := url.Parse(c.baseURL)
 	if err != nil {
 		return Rates{}, fmt.Errorf("parse base url: %w", err)
 	}
 
+	u = u.JoinPath(lang, "json")
+
 	q := u.Query()
 
 	for _, code := range params.CurrencyCodes {
@@ -101,14 +165,62 @@ func (c client) Rates(ctx context.Context, opts ...option.RatesOption) (Rates, e
 
 	u.RawQuery = q.Encode()
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), http.NoBody)
+	body, err := c.getWithRetries(ctx, u.String())
 	if err != nil {
-		return Rates{}, fmt.Errorf("create request: %w", err)
+		return Rates{}, err
+	}
+
+	resp, err := unmarshalRatesResponse(body)
+	if err != nil {
+		return Rates{}, &DecodeError{Err: err}
+	}
+
+	rates := maybeAddGELCodeToResponse(resp.Rates(), params.CurrencyCodes, lang)
+
+	return sortRates(rates), nil
+}
+
+// getWithRetries sends GET request to rawURL and retries it on transient failures according to retry policy.
+func (c client) getWithRetries(ctx context.Context, rawURL string) ([]byte, error) {
+	for attempt := 0; ; attempt++ {
+		if err := c.limiter.Wait(ctx); err != nil {
+			return nil, fmt.Errorf("wait for rate limit: %w", err)
+		}
+
+		body, err := c.get(ctx, rawURL)
+		if err == nil {
+			return body, nil
+		}
+
+		if attempt >= c.retry.maxRetries || ctx.Err() != nil || !retryable(err) {
+			return nil, err
+		}
+
+		delay := c.retry.delay(attempt, retryAfter(err))
+
+		log.WithError(ctx, err).WithField("attempt", attempt+1).WithField("delay", delay.String()).
+			Debug("Retrying nbg.gov.ge request")
+
+		if err = sleep(ctx, delay); err != nil {
+			return nil, fmt.Errorf("wait for retry: %w", err)
+		}
+	}
+}
+
+// get sends GET request to rawURL and returns response body.
+func (c client) get(ctx context.Context, rawURL string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+
+	if c.userAgent != "" {
+		req.Header.Set("User-Agent", c.userAgent)
 	}
 
 	res, err := c.Do(req)
 	if err != nil {
-		return Rates{}, fmt.Errorf("send request: %w", err)
+		return nil, fmt.Errorf("send request: %w", err)
 	}
 
 	defer func() {
@@ -118,22 +230,19 @@ func (c client) Rates(ctx context.Context, opts ...option.RatesOption) (Rates, e
 	}()
 
 	if res.StatusCode != http.StatusOK {
-		return Rates{}, fmt.Errorf("invalid response status: %s", res.Status)
+		return nil, &StatusError{
+			StatusCode: res.StatusCode,
+			Status:     res.Status,
+			RetryAfter: parseRetryAfter(res.Header.Get("Retry-After"), time.Now()),
+		}
 	}
 
 	body, err := io.ReadAll(res.Body)
 	if err != nil {
-		return Rates{}, fmt.Errorf("read response body: %w", err)
-	}
-
-	resp, err := unmarshalRatesResponse(body)
-	if err != nil {
-		return Rates{}, fmt.Errorf("unmarshal body to rates: %w", err)
+		return nil, fmt.Errorf("read response body: %w", err)
 	}
 
-	rates := maybeAddGELCodeToResponse(resp.Rates(), params.CurrencyCodes)
-
-	return sortRates(rates), nil
+	return body, nil
 }
 
 func sortRates(r Rates) Rates {
@@ -144,15 +253,20 @@ func sortRates(r Rates) Rates {
 	return r
 }
 
-func maybeAddGELCodeToResponse(r Rates, codes []string) Rates {
+// gelNames are names of GEL by language.
+var gelNames = map[string]string{
+	LanguageEnglish:  "Georgian Lari",
+	LanguageGeorgian: "ქართული ლარი",
+	LanguageRussian:  "Грузинский лари",
+}
+
+func maybeAddGELCodeToResponse(r Rates, codes []string, lang string) Rates {
 	const (
-		gelCode      = "GEL"
 		rateFormated = "1.0000"
 		diffFormated = "0.0000"
 		qty          = 1
 		rate         = 1
 		diff         = 0
-		name         = "Georgian Lari"
 	)
 	// Add GEL if no codes specified or GEL is specified.
 	shouldAddGEL := len(codes) == 0 || slices.Contains(codes, gelCode)
@@ -169,7 +283,7 @@ func maybeAddGELCodeToResponse(r Rates, codes []string) Rates {
 			RateFormated:  rateFormated,
 			DiffFormated:  diffFormated,
 			Rate:          rate,
-			Name:          name,
+			Name:          gelNames[lang],
 			Diff:          diff,
 			Date:          r.Date,
 			ValidFromDate: r.Date,
@@ -14,7 +14,7 @@ func TestNewCached(t *testing.T) {
 	assert.NotNil(t, client)
 	assert.Equal(t, time.Hour, client.ttl)
 	assert.NotNil(t, client.client)
-	assert.NotNil(t, client.cache)
+	assert.NotNil(t, client.store)
 }
 
 func TestNewCachedWithTTL(t *testing.T) {
@@ -24,7 +24,7 @@ func TestNewCachedWithTTL(t *testing.T) {
 	assert.NotNil(t, client)
 	assert.Equal(t, ttl, client.ttl)
 	assert.NotNil(t, client.client)
-	assert.NotNil(t, client.cache)
+	assert.NotNil(t, client.store)
 }
 
 func TestNewCachedWithHTTPClient(t *testing.T) {
@@ -34,7 +34,7 @@ func TestNewCachedWithHTTPClient(t *testing.T) {
 	assert.NotNil(t, client)
 	assert.Equal(t, time.Hour, client.ttl)
 	assert.NotNil(t, client.client)
-	assert.NotNil(t, client.cache)
+	assert.NotNil(t, client.store)
 }
 
 func TestNewCachedWithHTTPClientAndTTL(t *testing.T) {
@@ -45,5 +45,5 @@ func TestNewCachedWithHTTPClientAndTTL(t *testing.T) {
 	assert.NotNil(t, client)
 	assert.Equal(t, ttl, client.ttl)
 	assert.NotNil(t, client.client)
-	assert.NotNil(t, client.cache)
+	assert.NotNil(t, client.store)
 }
@@ -7,6 +7,7 @@
 	"fmt"
 	"io"
 	"net/http"
+	"net/http/httptest"
 	"path/filepath"
 	"strings"
 	"testing"
@@ -203,3 +204,80 @@ type args struct {
 		})
 	}
 }
+
+func TestClient_Rates_Options(t *testing.T) {
+	var (
+		gotPath      string
+		gotUserAgent string
+	)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotUserAgent = r.UserAgent()
+
+		_, _ = w.Write([]byte(`[{"date":"2023-12-01T00:00:00.000Z","currencies":[{"code":"USD","quantity":1,"rate":2.7}]}]`))
+	}))
+	t.Cleanup(srv.Close)
+
+	tests := []struct {
+		name          string
+		clientOpts    []ClientOption
+		opts          []option.RatesOption
+		wantPath      string
+		wantUserAgent string
+		wantGELName   string
+		wantErr       error
+	}{
+		{
+			name:        "defaults",
+			wantPath:    "/mirror/en/json",
+			wantGELName: "Georgian Lari",
+		},
+		{
+			name:          "client language and user agent",
+			clientOpts:    []ClientOption{WithLanguage(LanguageGeorgian), WithUserAgent("ge-tax-calc/1.0")},
+			wantPath:      "/mirror/ka/json",
+			wantUserAgent: "ge-tax-calc/1.0",
+			wantGELName:   "ქართული ლარი",
+		},
+		{
+			name:        "request language overrides client language",
+			clientOpts:  []ClientOption{WithLanguage(LanguageGeorgian)},
+			opts:        []option.RatesOption{option.WithLanguage(LanguageRussian)},
+			wantPath:    "/mirror/ru/json",
+			wantGELName: "Грузинский лари",
+		},
+		{
+			name:    "unsupported language",
+			opts:    []option.RatesOption{option.WithLanguage("de")},
+			wantErr: ErrUnsupportedLanguage,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotPath, gotUserAgent = "", ""
+
+			opts := append([]ClientOption{WithBaseURL(srv.URL + "/mirror")}, tt.clientOpts...)
+
+			rates, err := NewWithHTTPClient(srv.Client(), opts...).Rates(context.Background(), tt.opts...)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.Empty(t, gotPath)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantPath, gotPath)
+
+			if tt.wantUserAgent != "" {
+				assert.Equal(t, tt.wantUserAgent, gotUserAgent)
+			}
+
+			gel, err := rates.CurrencyByCode(gelCode)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantGELName, gel.Name)
+		})
+	}
+}
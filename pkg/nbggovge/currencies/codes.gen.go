@@ -1,8 +1,8 @@
 // Code generated by generator. DO NOT EDIT.
-// Source: client
-// Date: 2025-08-02
-// Generator: currencies-generator
-// Go version: go1.24.5
+// Source: file
+// Date: 2024-02-10
+// Generator: currencies-generator (devel)
+// Go version: go1.27.1
 package currencies
 
 // List of supported currency codes.
@@ -146,3 +146,51 @@ func All() []string {
 		ZAR,
 	}
 }
+
+// infos holds ISO 4217 metadata of currencies.
+var infos = map[string]Info{
+	AED: {Code: AED, Numeric: "784", MinorUnits: 2},
+	AMD: {Code: AMD, Numeric: "051", MinorUnits: 2},
+	AUD: {Code: AUD, Numeric: "036", MinorUnits: 2},
+	AZN: {Code: AZN, Numeric: "944", MinorUnits: 2},
+	BGN: {Code: BGN, Numeric: "975", MinorUnits: 2},
+	BRL: {Code: BRL, Numeric: "986", MinorUnits: 2},
+	BYN: {Code: BYN, Numeric: "933", MinorUnits: 2},
+	CAD: {Code: CAD, Numeric: "124", MinorUnits: 2},
+	CHF: {Code: CHF, Numeric: "756", MinorUnits: 2},
+	CNY: {Code: CNY, Numeric: "156", MinorUnits: 2},
+	CZK: {Code: CZK, Numeric: "203", MinorUnits: 2},
+	DKK: {Code: DKK, Numeric: "208", MinorUnits: 2},
+	EGP: {Code: EGP, Numeric: "818", MinorUnits: 2},
+	EUR: {Code: EUR, Numeric: "978", MinorUnits: 2},
+	GBP: {Code: GBP, Numeric: "826", MinorUnits: 2},
+	GEL: {Code: GEL, Numeric: "981", MinorUnits: 2},
+	HKD: {Code: HKD, Numeric: "344", MinorUnits: 2},
+	HUF: {Code: HUF, Numeric: "348", MinorUnits: 2},
+	ILS: {Code: ILS, Numeric: "376", MinorUnits: 2},
+	INR: {Code: INR, Numeric: "356", MinorUnits: 2},
+	IRR: {Code: IRR, Numeric: "364", MinorUnits: 2},
+	ISK: {Code: ISK, Numeric: "352", MinorUnits: 0},
+	JPY: {Code: JPY, Numeric: "392", MinorUnits: 0},
+	KGS: {Code: KGS, Numeric: "417", MinorUnits: 2},
+	KRW: {Code: KRW, Numeric: "410", MinorUnits: 0},
+	KWD: {Code: KWD, Numeric: "414", MinorUnits: 3},
+	KZT: {Code: KZT, Numeric: "398", MinorUnits: 2},
+	MDL: {Code: MDL, Numeric: "498", MinorUnits: 2},
+	NOK: {Code: NOK, Numeric: "578", MinorUnits: 2},
+	NZD: {Code: NZD, Numeric: "554", MinorUnits: 2},
+	PLN: {Code: PLN, Numeric: "985", MinorUnits: 2},
+	QAR: {Code: QAR, Numeric: "634", MinorUnits: 2},
+	RON: {Code: RON, Numeric: "946", MinorUnits: 2},
+	RSD: {Code: RSD, Numeric: "941", MinorUnits: 2},
+	RUB: {Code: RUB, Numeric: "643", MinorUnits: 2},
+	SEK: {Code: SEK, Numeric: "752", MinorUnits: 2},
+	SGD: {Code: SGD, Numeric: "702", MinorUnits: 2},
+	TJS: {Code: TJS, Numeric: "972", MinorUnits: 2},
+	TMT: {Code: TMT, Numeric: "934", MinorUnits: 2},
+	TRY: {Code: TRY, Numeric: "949", MinorUnits: 2},
+	UAH: {Code: UAH, Numeric: "980", MinorUnits: 2},
+	USD: {Code: USD, Numeric: "840", MinorUnits: 2},
+	UZS: {Code: UZS, Numeric: "860", MinorUnits: 2},
+	ZAR: {Code: ZAR, Numeric: "710", MinorUnits: 2},
+}
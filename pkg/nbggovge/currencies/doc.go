@@ -1,2 +1,2 @@
-// Package currencies contains currency codes constants.
+// Package currencies contains currency codes constants and their ISO 4217 metadata.
 package currencies
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+)
+
+// errNoISOData returned when ISO 4217 table has no entry for currency code.
+var errNoISOData = errors.New("no ISO 4217 data")
+
+// isoInfo is ISO 4217 metadata of currency.
+type isoInfo struct {
+	numeric    string
+	minorUnits int
+}
+
+// iso4217 holds numeric codes and minor units of currencies published by NBG.
+// When NBG starts publishing a new currency, add it here before regenerating codes.
+var iso4217 = map[string]isoInfo{
+	"AED": {numeric: "784", minorUnits: 2},
+	"AMD": {numeric: "051", minorUnits: 2},
+	"AUD": {numeric: "036", minorUnits: 2},
+	"AZN": {numeric: "944", minorUnits: 2},
+	"BGN": {numeric: "975", minorUnits: 2},
+	"BHD": {numeric: "048", minorUnits: 3},
+	"BRL": {numeric: "986", minorUnits: 2},
+	"BYN": {numeric: "933", minorUnits: 2},
+	"CAD": {numeric: "124", minorUnits: 2},
+	"CHF": {numeric: "756", minorUnits: 2},
+	"CNY": {numeric: "156", minorUnits: 2},
+	"CZK": {numeric: "203", minorUnits: 2},
+	"DKK": {numeric: "208", minorUnits: 2},
+	"EGP": {numeric: "818", minorUnits: 2},
+	"EUR": {numeric: "978", minorUnits: 2},
+	"GBP": {numeric: "826", minorUnits: 2},
+	"GEL": {numeric: "981", minorUnits: 2},
+	"HKD": {numeric: "344", minorUnits: 2},
+	"HUF": {numeric: "348", minorUnits: 2},
+	"ILS": {numeric: "376", minorUnits: 2},
+	"INR": {numeric: "356", minorUnits: 2},
+	"IRR": {numeric: "364", minorUnits: 2},
+	"ISK": {numeric: "352", minorUnits: 0},
+	"JOD": {numeric: "400", minorUnits: 3},
+	"JPY": {numeric: "392", minorUnits: 0},
+	"KGS": {numeric: "417", minorUnits: 2},
+	"KRW": {numeric: "410", minorUnits: 0},
+	"KWD": {numeric: "414", minorUnits: 3},
+	"KZT": {numeric: "398", minorUnits: 2},
+	"MDL": {numeric: "498", minorUnits: 2},
+	"NOK": {numeric: "578", minorUnits: 2},
+	"NZD": {numeric: "554", minorUnits: 2},
+	"OMR": {numeric: "512", minorUnits: 3},
+	"PLN": {numeric: "985", minorUnits: 2},
+	"QAR": {numeric: "634", minorUnits: 2},
+	"RON": {numeric: "946", minorUnits: 2},
+	"RSD": {numeric: "941", minorUnits: 2},
+	"RUB": {numeric: "643", minorUnits: 2},
+	"SEK": {numeric: "752", minorUnits: 2},
+	"SGD": {numeric: "702", minorUnits: 2},
+	"TJS": {numeric: "972", minorUnits: 2},
+	"TMT": {numeric: "934", minorUnits: 2},
+	"TRY": {numeric: "949", minorUnits: 2},
+	"UAH": {numeric: "980", minorUnits: 2},
+	"USD": {numeric: "840", minorUnits: 2},
+	"UZS": {numeric: "860", minorUnits: 2},
+	"ZAR": {numeric: "710", minorUnits: 2},
+}
+
+// addISOData fills numeric codes and minor units of currencies.
+func addISOData(currencies []currencyInfo) ([]currencyInfo, error) {
+	for i := range currencies {
+		info, ok := iso4217[currencies[i].Code]
+		if !ok {
+			return nil, fmt.Errorf("%s: %w", currencies[i].Code, errNoISOData)
+		}
+
+		currencies[i].Numeric = info.numeric
+		currencies[i].MinorUnits = info.minorUnits
+	}
+
+	return currencies, nil
+}
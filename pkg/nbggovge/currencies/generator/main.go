@@ -32,8 +32,10 @@
 var templateFS embed.FS
 
 type currencyInfo struct {
-	Code string
-	Name string
+	Code       string
+	Name       string
+	Numeric    string
+	MinorUnits int
 }
 
 type versionInfo struct {
@@ -81,7 +83,10 @@ func main() {
 		})
 	}
 
-	currencies = mutateCurrencyList(currencies)
+	currencies, err = addISOData(mutateCurrencyList(currencies))
+	if err != nil {
+		log.WithError(ctx, err).Fatal("Failed to add ISO 4217 data")
+	}
 
 	t, err := template.ParseFS(templateFS, templateName)
 	if err != nil {
@@ -0,0 +1,31 @@
+package currencies
+
+// DefaultMinorUnits is a number of minor units of currencies without ISO 4217 metadata.
+const DefaultMinorUnits int32 = 2
+
+// Info is ISO 4217 metadata of currency.
+type Info struct {
+	// Code is an alphabetic code, e.g. USD.
+	Code string
+	// Numeric is a three-digit numeric code, e.g. 840.
+	Numeric string
+	// MinorUnits is a number of digits after the decimal separator, e.g. 2 for cents, 0 for JPY.
+	MinorUnits int32
+}
+
+// Lookup returns ISO 4217 metadata of currency.
+func Lookup(code string) (Info, bool) {
+	info, ok := infos[code]
+
+	return info, ok
+}
+
+// MinorUnits returns number of minor units of currency, DefaultMinorUnits for unknown currency.
+func MinorUnits(code string) int32 {
+	info, ok := Lookup(code)
+	if !ok {
+		return DefaultMinorUnits
+	}
+
+	return info.MinorUnits
+}
@@ -0,0 +1,42 @@
+package currencies
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLookup(t *testing.T) {
+	for _, code := range All() {
+		info, ok := Lookup(code)
+		if assert.True(t, ok, code) {
+			assert.Equal(t, code, info.Code)
+			assert.Len(t, info.Numeric, 3, code)
+		}
+	}
+
+	info, ok := Lookup(GEL)
+	assert.True(t, ok)
+	assert.Equal(t, Info{Code: GEL, Numeric: "981", MinorUnits: 2}, info)
+
+	_, ok = Lookup("XXX")
+	assert.False(t, ok)
+}
+
+func TestMinorUnits(t *testing.T) {
+	tests := []struct {
+		code string
+		want int32
+	}{
+		{code: USD, want: 2},
+		{code: JPY, want: 0},
+		{code: KWD, want: 3},
+		{code: "XXX", want: DefaultMinorUnits},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			assert.Equal(t, tt.want, MinorUnits(tt.code))
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package nbggovge
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ErrUnsupportedLanguage returned when requested language of currency names is not supported by API.
+var ErrUnsupportedLanguage = errors.New("unsupported language")
+
+// StatusError is returned when API responds with not OK status.
+type StatusError struct {
+	// StatusCode is HTTP status code of response.
+	StatusCode int
+	// Status is HTTP status of response, e.g. "503 Service Unavailable".
+	Status string
+	// RetryAfter is a delay requested by Retry-After header, zero when header is not set.
+	RetryAfter time.Duration
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("invalid response status: %s", e.Status)
+}
+
+// DecodeError is returned when API response could not be decoded.
+type DecodeError struct {
+	Err error
+}
+
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("unmarshal body to rates: %v", e.Err)
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// UnknownCurrencyError is returned when rates have no currency with requested code.
+// It matches ErrCodeNotFound with errors.Is.
+type UnknownCurrencyError struct {
+	Code string
+	// Date of rates, zero when rates have no date.
+	Date time.Time
+}
+
+func (e *UnknownCurrencyError) Error() string {
+	if e.Date.IsZero() {
+		return fmt.Sprintf("%s: %v", e.Code, ErrCodeNotFound)
+	}
+
+	return fmt.Sprintf("%s on %s: %v", e.Code, e.Date.Format(time.DateOnly), ErrCodeNotFound)
+}
+
+func (e *UnknownCurrencyError) Is(target error) bool {
+	return target == ErrCodeNotFound
+}
+
+// parseDate parses date returned by API as 2023-09-09T00:00:00.000Z, where only the date part is meaningful.
+func parseDate(raw string) (time.Time, error) {
+	day, _, _ := strings.Cut(raw, "T")
+
+	return time.Parse(time.DateOnly, day)
+}
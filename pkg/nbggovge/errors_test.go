@@ -0,0 +1,81 @@
+package nbggovge
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/obalunenko/georgia-tax-calculator/pkg/nbggovge/currencies"
+	"github.com/obalunenko/georgia-tax-calculator/pkg/nbggovge/option"
+)
+
+func TestClient_Rates_StatusError(t *testing.T) {
+	doer := &sequenceDoer{responses: []func() (*http.Response, error){
+		respond(http.StatusTooManyRequests, http.Header{"Retry-After": []string{"3600"}}, ""),
+	}}
+
+	_, err := NewWithHTTPClient(doer).Rates(context.Background(), option.WithCurrency(currencies.USD))
+
+	var serr *StatusError
+
+	require.ErrorAs(t, err, &serr)
+	assert.Equal(t, http.StatusTooManyRequests, serr.StatusCode)
+	assert.Equal(t, time.Hour, serr.RetryAfter)
+}
+
+func TestClient_Rates_DecodeError(t *testing.T) {
+	doer := &sequenceDoer{responses: []func() (*http.Response, error){
+		respond(http.StatusOK, nil, "<html>maintenance</html>"),
+	}}
+
+	_, err := NewWithHTTPClient(doer).Rates(context.Background(), option.WithCurrency(currencies.USD))
+
+	var derr *DecodeError
+
+	require.ErrorAs(t, err, &derr)
+	assert.Error(t, derr.Unwrap())
+}
+
+func TestRates_CurrencyByCode_UnknownCurrencyError(t *testing.T) {
+	tests := []struct {
+		name    string
+		r       Rates
+		want    UnknownCurrencyError
+		wantMsg string
+	}{
+		{
+			name: "with date",
+			r:    Rates{Date: "2023-09-08T00:00:00.000Z"},
+			want: UnknownCurrencyError{
+				Code: currencies.EUR,
+				Date: time.Date(2023, time.September, 8, 0, 0, 0, 0, time.UTC),
+			},
+			wantMsg: "EUR on 2023-09-08: code not found in set",
+		},
+		{
+			name: "without date",
+			r:    Rates{},
+			want: UnknownCurrencyError{
+				Code: currencies.EUR,
+			},
+			wantMsg: "EUR: code not found in set",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.r.CurrencyByCode(currencies.EUR)
+			require.ErrorIs(t, err, ErrCodeNotFound)
+
+			var uerr *UnknownCurrencyError
+
+			require.ErrorAs(t, err, &uerr)
+			assert.Equal(t, tt.want, *uerr)
+			assert.EqualError(t, err, tt.wantMsg)
+		})
+	}
+}
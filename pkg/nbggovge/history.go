@@ -0,0 +1,149 @@
+package nbggovge
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/obalunenko/georgia-tax-calculator/pkg/nbggovge/option"
+)
+
+// ErrInvalidRange returned when range of dates is empty or too long.
+var ErrInvalidRange = errors.New("invalid date range")
+
+const (
+	// rangeConcurrency limits number of concurrent requests of range.
+	rangeConcurrency = 4
+	// maxRangeDays limits length of range.
+	maxRangeDays = 5 * 366
+)
+
+// RatePoint is a rate of currency valid since Date.
+type RatePoint struct {
+	Date time.Time
+	Currency
+}
+
+// RatesHistory is a time series of rates by currency code. Points of each currency are sorted by date.
+type RatesHistory map[string][]RatePoint
+
+// RatesRange returns history of rates of c published from one date to another inclusive.
+// Clients implementing RangeClient return history themselves, rates of other clients are requested date by date.
+// Currencies and language could be set by options, date options are ignored.
+func RatesRange(ctx context.Context, c Client, from, to time.Time, opts ...option.RatesOption) (RatesHistory, error) {
+	if rc, ok := c.(RangeClient); ok {
+		return rc.RatesRange(ctx, from, to, opts...)
+	}
+
+	return ratesRange(ctx, c, from, to, opts)
+}
+
+// RatesRange returns history of rates published from one date to another inclusive.
+// Currencies and language could be set by options, date options are ignored.
+func (c client) RatesRange(ctx context.Context, from, to time.Time, opts ...option.RatesOption) (RatesHistory, error) {
+	return ratesRange(ctx, c, from, to, opts)
+}
+
+// RatesRange returns history of rates published from one date to another inclusive.
+// Rates of each date are requested with Rates, so they are cached and repeated ranges are served from cache.
+func (c *CachedClient) RatesRange(ctx context.Context, from, to time.Time, opts ...option.RatesOption) (RatesHistory, error) {
+	return ratesRange(ctx, c, from, to, opts)
+}
+
+// ratesRange requests rates of each date of range with bounded concurrency and merges them to history.
+// Rates are not published on weekends and holidays, rates of these dates are valid since an earlier date,
+// so each rate is added to history once by the date since it is valid. Rates valid since dates out of range are skipped.
+func ratesRange(ctx context.Context, c Client, from, to time.Time, opts []option.RatesOption) (RatesHistory, error) {
+	from = truncateDay(from)
+	to = truncateDay(to)
+
+	if to.Before(from) {
+		return nil, fmt.Errorf("%s is before %s: %w", to.Format(time.DateOnly), from.Format(time.DateOnly), ErrInvalidRange)
+	}
+
+	var days []time.Time
+
+	// Days are added by date, as hours between dates change with daylight saving time.
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		if len(days) == maxRangeDays {
+			return nil, fmt.Errorf("longer than %d days: %w", maxRangeDays, ErrInvalidRange)
+		}
+
+		days = append(days, day)
+	}
+
+	results := make([]Rates, len(days))
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(rangeConcurrency)
+
+	for i, day := range days {
+		g.Go(func() error {
+			// Date option goes last, so it overrides date options of caller.
+			rates, err := c.Rates(gctx, append(slices.Clone(opts), option.WithDate(day))...)
+			if err != nil {
+				return fmt.Errorf("rates of %s: %w", day.Format(time.DateOnly), err)
+			}
+
+			results[i] = rates
+
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	return mergeHistory(results, from, to)
+}
+
+// mergeHistory merges rates of dates to history, rates are added once by the date since they are valid.
+func mergeHistory(results []Rates, from, to time.Time) (RatesHistory, error) {
+	history := make(RatesHistory)
+
+	for _, rates := range results {
+		for _, currency := range rates.Currencies {
+			validFrom, err := currency.ValidFrom()
+			if err != nil {
+				return nil, err
+			}
+
+			// Date since rate is valid is the same in any location.
+			validFrom = time.Date(validFrom.Year(), validFrom.Month(), validFrom.Day(), 0, 0, 0, 0, from.Location())
+
+			if validFrom.Before(from) || validFrom.After(to) {
+				continue
+			}
+
+			points := history[currency.Code]
+
+			if slices.ContainsFunc(points, func(p RatePoint) bool {
+				return p.Date.Equal(validFrom)
+			}) {
+				continue
+			}
+
+			history[currency.Code] = append(points, RatePoint{Date: validFrom, Currency: currency})
+		}
+	}
+
+	for code, points := range history {
+		slices.SortFunc(points, func(a, b RatePoint) int {
+			return a.Date.Compare(b.Date)
+		})
+
+		history[code] = points
+	}
+
+	return history, nil
+}
+
+// truncateDay returns midnight of the day of t in location of t.
+func truncateDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
@@ -0,0 +1,176 @@
+package nbggovge
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/obalunenko/georgia-tax-calculator/pkg/nbggovge/internal"
+	"github.com/obalunenko/georgia-tax-calculator/pkg/nbggovge/option"
+)
+
+// historyClient returns rates valid for requested date from history of published rates, safe for concurrent use.
+type historyClient struct {
+	calls   atomic.Int32
+	history map[string]Rates
+	fail    string
+}
+
+func (h *historyClient) Rates(_ context.Context, opts ...option.RatesOption) (Rates, error) {
+	h.calls.Add(1)
+
+	var params internal.RatesParams
+
+	for _, opt := range opts {
+		opt.Apply(&params)
+	}
+
+	day := params.Date.Format(time.DateOnly)
+	if day == h.fail {
+		return Rates{}, errors.New("upstream failure")
+	}
+
+	return filterRates(h.history[day], sortedCodes(params.CurrencyCodes)), nil
+}
+
+func usdRates(date, validFrom string, rate float64) Rates {
+	return Rates{
+		Date: date + "T00:00:00.000Z",
+		Currencies: []Currency{
+			{Code: "EUR", Quantity: 1, Rate: rate + 0.3, ValidFromDate: validFrom + "T00:00:00.000Z"},
+			{Code: "USD", Quantity: 1, Rate: rate, ValidFromDate: validFrom + "T00:00:00.000Z"},
+		},
+	}
+}
+
+// newHistoryClient returns client with rates published on Friday 2023-09-08 and Monday 2023-09-11.
+// Weekend requests return rates valid since Friday.
+func newHistoryClient() *historyClient {
+	return &historyClient{
+		history: map[string]Rates{
+			"2023-09-07": usdRates("2023-09-07", "2023-09-07", 2.6),
+			"2023-09-08": usdRates("2023-09-08", "2023-09-08", 2.61),
+			"2023-09-09": usdRates("2023-09-09", "2023-09-08", 2.61),
+			"2023-09-10": usdRates("2023-09-10", "2023-09-08", 2.61),
+			"2023-09-11": usdRates("2023-09-11", "2023-09-11", 2.63),
+		},
+	}
+}
+
+func day(d int) time.Time {
+	return time.Date(2023, time.September, d, 0, 0, 0, 0, time.UTC)
+}
+
+func pointDates(points []RatePoint) []time.Time {
+	dates := make([]time.Time, 0, len(points))
+
+	for _, p := range points {
+		dates = append(dates, p.Date)
+	}
+
+	return dates
+}
+
+func TestRatesRange(t *testing.T) {
+	tests := []struct {
+		name      string
+		from      time.Time
+		to        time.Time
+		opts      []option.RatesOption
+		wantCodes []string
+		wantDates []time.Time
+		wantErr   error
+	}{
+		{
+			name:      "weekend is skipped",
+			from:      day(7),
+			to:        day(11),
+			opts:      []option.RatesOption{option.WithCurrency("USD")},
+			wantCodes: []string{"USD"},
+			wantDates: []time.Time{day(7), day(8), day(11)},
+		},
+		{
+			name:      "all currencies",
+			from:      day(8),
+			to:        day(11),
+			wantCodes: []string{"EUR", "USD"},
+			wantDates: []time.Time{day(8), day(11)},
+		},
+		{
+			name:      "rates valid since date before range are skipped",
+			from:      day(9),
+			to:        day(11).Add(time.Hour * 15),
+			opts:      []option.RatesOption{option.WithCurrency("USD")},
+			wantCodes: []string{"USD"},
+			wantDates: []time.Time{day(11)},
+		},
+		{
+			name:    "to before from",
+			from:    day(11),
+			to:      day(7),
+			wantErr: ErrInvalidRange,
+		},
+		{
+			name:    "too long",
+			from:    day(1),
+			to:      day(1).AddDate(6, 0, 0),
+			wantErr: ErrInvalidRange,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := RatesRange(context.Background(), newHistoryClient(), tt.from, tt.to, tt.opts...)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Len(t, got, len(tt.wantCodes))
+
+			for _, code := range tt.wantCodes {
+				assert.Equal(t, tt.wantDates, pointDates(got[code]), code)
+			}
+		})
+	}
+}
+
+func TestRatesRange_Error(t *testing.T) {
+	cli := newHistoryClient()
+	cli.fail = "2023-09-09"
+
+	_, err := RatesRange(context.Background(), cli, day(7), day(11))
+	require.ErrorContains(t, err, "rates of 2023-09-09: upstream failure")
+}
+
+func TestRangeClient(t *testing.T) {
+	assert.Implements(t, (*RangeClient)(nil), New())
+	assert.Implements(t, (*RangeClient)(nil), NewCachedClient(newHistoryClient(), time.Hour))
+	assert.NotImplements(t, (*RangeClient)(nil), newHistoryClient())
+}
+
+func TestCachedClient_RatesRange(t *testing.T) {
+	inner := newHistoryClient()
+	cachedClient := NewCachedClient(inner, time.Hour)
+
+	ctx := context.Background()
+
+	first, err := cachedClient.RatesRange(ctx, day(7), day(11), option.WithCurrency("USD"))
+	require.NoError(t, err)
+	assert.Equal(t, int32(5), inner.calls.Load())
+
+	assert.Equal(t, 2.63, first["USD"][2].Rate)
+
+	// Repeated range is served from cache.
+	second, err := RatesRange(ctx, cachedClient, day(7), day(11), option.WithCurrency("USD"))
+	require.NoError(t, err)
+	assert.Equal(t, first, second)
+	assert.Equal(t, int32(5), inner.calls.Load())
+}
@@ -9,4 +9,6 @@
 type RatesParams struct {
 	Date          time.Time
 	CurrencyCodes []string
+	// Language of currency names. Empty language means default language of client.
+	Language string
 }
@@ -8,6 +8,7 @@
 	"io"
 	"net/http"
 	"slices"
+	"strings"
 	"time"
 
 	"github.com/obalunenko/georgia-tax-calculator/pkg/nbggovge"
@@ -17,7 +18,7 @@
 
 // NewClient creates a new mock client.
 // It returns a client that returns data for each request.
-// If currency is not found in data, it returns http.StatusNotFound.
+// If currency is not found in data, it returns http.StatusNotFound. No currency means all currencies.
 // if date is empty, it returns current date in response.
 func NewClient(data []nbggovge.Rates) nbggovge.Client {
 	return nbggovge.NewWithHTTPClient(mockRatesHTTPClient{data: data})
@@ -31,12 +32,14 @@ func (d mockRatesHTTPClient) Do(req *http.Request) (*http.Response, error) {
 	q := req.URL.Query()
 
 	cur := q.Get(internal.CurrencyCodesParam)
-	date := q.Get(internal.CurrencyCodesParam)
+	date := q.Get(internal.DateParam)
 
 	if date == "" {
 		date = time.Now().Format(internal.DateLayout)
 	}
 
+	date = apiDate(date)
+
 	rates := d.data
 
 	for i := range rates {
@@ -57,6 +60,50 @@ func (d mockRatesHTTPClient) Do(req *http.Request) (*http.Response, error) {
 		rates[i] = r
 	}
 
+	return newResponse(req, rates, cur)
+}
+
+// NewHistoryClient creates a new mock client that returns rates published for requested date.
+// Rates are matched by date, so dates missing in history have no rates, as weekends and holidays.
+// If currency is not found in data, it returns http.StatusNotFound. No currency means all currencies.
+func NewHistoryClient(history []nbggovge.Rates) nbggovge.Client {
+	return nbggovge.NewWithHTTPClient(historyHTTPClient{history: history})
+}
+
+type historyHTTPClient struct {
+	history []nbggovge.Rates
+}
+
+func (h historyHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	q := req.URL.Query()
+
+	cur := q.Get(internal.CurrencyCodesParam)
+	date := q.Get(internal.DateParam)
+
+	rates := []nbggovge.Rates{
+		{
+			Date:       apiDate(date),
+			Currencies: []nbggovge.Currency{},
+		},
+	}
+
+	for _, r := range h.history {
+		if strings.HasPrefix(r.Date, date) {
+			rates = []nbggovge.Rates{r}
+
+			break
+		}
+	}
+
+	return newResponse(req, rates, cur)
+}
+
+// apiDate formats date as it is returned by API.
+func apiDate(date string) string {
+	return date + "T00:00:00.000Z"
+}
+
+func newResponse(req *http.Request, rates []nbggovge.Rates, cur string) (*http.Response, error) {
 	body, err := json.Marshal(rates)
 	if err != nil {
 		return nil, fmt.Errorf("marshal body: %w", err)
@@ -64,7 +111,7 @@ func (d mockRatesHTTPClient) Do(req *http.Request) (*http.Response, error) {
 
 	status := http.StatusOK
 
-	if !slices.Contains(currencies.All(), cur) {
+	if cur != "" && !slices.Contains(currencies.All(), cur) {
 		status = http.StatusNotFound
 		body = nil
 	}
@@ -52,3 +52,14 @@ func (w withCurrency) Apply(p *internal.RatesParams) {
 func WithCurrency(code string) RatesOption {
 	return withCurrency(code)
 }
+
+type withLanguage string
+
+func (w withLanguage) Apply(p *internal.RatesParams) {
+	p.Language = string(w)
+}
+
+// WithLanguage sets language of currency names in response, e.g. "en", "ka" or "ru".
+func WithLanguage(lang string) RatesOption {
+	return withLanguage(lang)
+}
@@ -0,0 +1,67 @@
+package nbggovge
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// limiter is a token bucket rate limiter.
+type limiter struct {
+	mu sync.Mutex
+	// rate is a number of tokens added per second.
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newLimiter(rps float64, burst int) *limiter {
+	b := float64(max(burst, 1))
+
+	return &limiter{
+		rate:   rps,
+		burst:  b,
+		tokens: b,
+		last:   time.Now(),
+	}
+}
+
+// Wait blocks until request is allowed or ctx is done. Nil limiter allows all requests.
+func (l *limiter) Wait(ctx context.Context) error {
+	if l == nil || l.rate <= 0 {
+		return nil
+	}
+
+	l.mu.Lock()
+
+	now := time.Now()
+
+	l.tokens = min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	l.last = now
+
+	// Token is reserved right away, so concurrent callers wait in turn.
+	l.tokens--
+
+	var wait time.Duration
+
+	if l.tokens < 0 {
+		wait = time.Duration(-l.tokens / l.rate * float64(time.Second))
+	}
+
+	l.mu.Unlock()
+
+	if wait == 0 {
+		return nil
+	}
+
+	if err := sleep(ctx, wait); err != nil {
+		l.mu.Lock()
+		l.tokens++
+		l.mu.Unlock()
+
+		return err
+	}
+
+	return nil
+}
@@ -3,7 +3,9 @@
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"strings"
+	"time"
 )
 
 // ErrCodeNotFound returned when specified code could not be found in set.
@@ -22,6 +24,17 @@ type Currency struct {
 	ValidFromDate string  `json:"validFromDate"`
 }
 
+// ValidFrom returns date since the rate is valid. It could be earlier than requested date,
+// because rates are not published on weekends and holidays.
+func (c Currency) ValidFrom() (time.Time, error) {
+	date, err := parseDate(c.ValidFromDate)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("parse valid from date %q: %w", c.ValidFromDate, err)
+	}
+
+	return date, nil
+}
+
 // Rates represents set of rates.
 type Rates struct {
 	Date       string     `json:"date"`
@@ -29,7 +42,7 @@ type Rates struct {
 }
 
 // CurrencyByCode returns Currency from set by specified code.
-// When no currency in set - ErrCodeNotFound returned.
+// When no currency in set - UnknownCurrencyError returned, that matches ErrCodeNotFound.
 func (r Rates) CurrencyByCode(code string) (Currency, error) {
 	for _, currency := range r.Currencies {
 		if strings.EqualFold(currency.Code, code) {
@@ -37,7 +50,10 @@ func (r Rates) CurrencyByCode(code string) (Currency, error) {
 		}
 	}
 
-	return Currency{}, ErrCodeNotFound
+	// Date is informational, so rates without valid date still report unknown currency.
+	date, _ := parseDate(r.Date)
+
+	return Currency{}, &UnknownCurrencyError{Code: code, Date: date}
 }
 
 // ratesResponse represents response.
@@ -4,6 +4,7 @@
 	"fmt"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -82,3 +83,42 @@ type args struct {
 		})
 	}
 }
+
+func TestCurrency_ValidFrom(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     string
+		want    time.Time
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name:    "api format",
+			raw:     "2024-02-10T00:00:00.000Z",
+			want:    time.Date(2024, time.February, 10, 0, 0, 0, 0, time.UTC),
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "date only",
+			raw:     "2024-02-10",
+			want:    time.Date(2024, time.February, 10, 0, 0, 0, 0, time.UTC),
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "empty",
+			raw:     "",
+			want:    time.Time{},
+			wantErr: assert.Error,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Currency{ValidFromDate: tt.raw}.ValidFrom()
+			if !tt.wantErr(t, err) {
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
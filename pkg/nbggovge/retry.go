@@ -0,0 +1,139 @@
+package nbggovge
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultMaxRetries is a default number of retries of failed request.
+	DefaultMaxRetries = 3
+	// DefaultBackoffBase is a default delay before the first retry.
+	DefaultBackoffBase = 200 * time.Millisecond
+	// DefaultBackoffMax is a default maximum delay between retries.
+	DefaultBackoffMax = 5 * time.Second
+
+	// maxRetryAfter limits delay requested by Retry-After header, longer delays fail the request.
+	maxRetryAfter = time.Minute
+)
+
+// ClientOption configures nbg.gov.ge API client.
+type ClientOption func(c *client)
+
+// WithMaxRetries sets number of retries of request failed with 5xx or 429 status or timeout.
+// Zero n disables retries. Default is DefaultMaxRetries.
+func WithMaxRetries(n int) ClientOption {
+	return func(c *client) {
+		c.retry.maxRetries = n
+	}
+}
+
+// WithBackoff sets exponential backoff between retries: delay starts from base
+// and doubles on each retry up to maxDelay, with random jitter.
+// Defaults are DefaultBackoffBase and DefaultBackoffMax.
+func WithBackoff(base, maxDelay time.Duration) ClientOption {
+	return func(c *client) {
+		c.retry.base = base
+		c.retry.max = maxDelay
+	}
+}
+
+// WithRateLimit limits requests to rps requests per second with bursts of up to burst requests.
+// Retries are limited too. By default, requests are not limited.
+func WithRateLimit(rps float64, burst int) ClientOption {
+	return func(c *client) {
+		c.limiter = newLimiter(rps, burst)
+	}
+}
+
+// retryPolicy defines how failed requests are retried.
+type retryPolicy struct {
+	maxRetries int
+	base       time.Duration
+	max        time.Duration
+}
+
+func defaultRetryPolicy() retryPolicy {
+	return retryPolicy{
+		maxRetries: DefaultMaxRetries,
+		base:       DefaultBackoffBase,
+		max:        DefaultBackoffMax,
+	}
+}
+
+// delay returns delay before retry number attempt starting from 0.
+// Delay is not shorter than retryAfter requested by server.
+func (p retryPolicy) delay(attempt int, retryAfter time.Duration) time.Duration {
+	d := p.max
+
+	if shift := uint(attempt); shift < 32 && p.base<<shift < p.max { //nolint:gosec // attempt is never negative.
+		d = p.base << shift
+	}
+
+	// Equal jitter: half of delay is fixed, another half is random.
+	if half := d / 2; half > 0 {
+		d = half + rand.N(half) //nolint:gosec // jitter does not need cryptographic randomness.
+	}
+
+	return max(d, retryAfter)
+}
+
+// retryable reports whether request failed with err could succeed when retried.
+func retryable(err error) bool {
+	var serr *StatusError
+	if errors.As(err, &serr) {
+		return (serr.StatusCode == http.StatusTooManyRequests || serr.StatusCode >= http.StatusInternalServerError) &&
+			serr.RetryAfter <= maxRetryAfter
+	}
+
+	var nerr net.Error
+
+	return errors.As(err, &nerr) && nerr.Timeout()
+}
+
+// retryAfter returns delay before retry requested by err.
+func retryAfter(err error) time.Duration {
+	var serr *StatusError
+	if errors.As(err, &serr) {
+		return serr.RetryAfter
+	}
+
+	return 0
+}
+
+// parseRetryAfter parses Retry-After header value, which is either delay in seconds or HTTP date.
+func parseRetryAfter(raw string, now time.Time) time.Duration {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return 0
+	}
+
+	if secs, err := strconv.Atoi(raw); err == nil {
+		return max(time.Duration(secs)*time.Second, 0)
+	}
+
+	if t, err := http.ParseTime(raw); err == nil {
+		return max(t.Sub(now), 0)
+	}
+
+	return 0
+}
+
+// sleep waits for d or until ctx is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
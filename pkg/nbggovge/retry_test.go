@@ -0,0 +1,241 @@
+package nbggovge
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/obalunenko/georgia-tax-calculator/pkg/nbggovge/option"
+)
+
+// sequenceDoer responds with next response of sequence, the last one is repeated.
+type sequenceDoer struct {
+	calls     atomic.Int32
+	responses []func() (*http.Response, error)
+}
+
+func (s *sequenceDoer) Do(req *http.Request) (*http.Response, error) {
+	i := int(s.calls.Add(1)) - 1
+	if i >= len(s.responses) {
+		i = len(s.responses) - 1
+	}
+
+	resp, err := s.responses[i]()
+	if resp != nil {
+		resp.Request = req
+	}
+
+	return resp, err
+}
+
+func respond(code int, header http.Header, body string) func() (*http.Response, error) {
+	return func() (*http.Response, error) {
+		return &http.Response{
+			Status:     http.StatusText(code),
+			StatusCode: code,
+			Header:     header,
+			Body:       io.NopCloser(bytes.NewReader([]byte(body))),
+		}, nil
+	}
+}
+
+// timeoutError is a net.Error of timed out request.
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+func fail(err error) func() (*http.Response, error) {
+	return func() (*http.Response, error) {
+		return nil, err
+	}
+}
+
+const okBody = `[{"date":"2023-12-01T00:00:00.000Z","currencies":[{"code":"USD","quantity":1,"rate":2.7}]}]`
+
+func TestClient_Rates_Retries(t *testing.T) {
+	tests := []struct {
+		name      string
+		responses []func() (*http.Response, error)
+		wantCalls int32
+		wantErr   bool
+	}{
+		{
+			name: "5xx then ok",
+			responses: []func() (*http.Response, error){
+				respond(http.StatusServiceUnavailable, nil, ""),
+				respond(http.StatusBadGateway, nil, ""),
+				respond(http.StatusOK, nil, okBody),
+			},
+			wantCalls: 3,
+		},
+		{
+			name: "429 with Retry-After then ok",
+			responses: []func() (*http.Response, error){
+				respond(http.StatusTooManyRequests, http.Header{"Retry-After": []string{"0"}}, ""),
+				respond(http.StatusOK, nil, okBody),
+			},
+			wantCalls: 2,
+		},
+		{
+			name: "timeout then ok",
+			responses: []func() (*http.Response, error){
+				fail(timeoutError{}),
+				respond(http.StatusOK, nil, okBody),
+			},
+			wantCalls: 2,
+		},
+		{
+			name: "5xx gives up after max retries",
+			responses: []func() (*http.Response, error){
+				respond(http.StatusInternalServerError, nil, ""),
+			},
+			wantCalls: 3,
+			wantErr:   true,
+		},
+		{
+			name: "4xx is not retried",
+			responses: []func() (*http.Response, error){
+				respond(http.StatusNotFound, nil, ""),
+			},
+			wantCalls: 1,
+			wantErr:   true,
+		},
+		{
+			name: "Retry-After too long is not retried",
+			responses: []func() (*http.Response, error){
+				respond(http.StatusTooManyRequests, http.Header{"Retry-After": []string{"3600"}}, ""),
+			},
+			wantCalls: 1,
+			wantErr:   true,
+		},
+		{
+			name: "network error is not retried",
+			responses: []func() (*http.Response, error){
+				fail(errors.New("connection refused")),
+			},
+			wantCalls: 1,
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doer := &sequenceDoer{responses: tt.responses}
+			cli := NewWithHTTPClient(doer, WithMaxRetries(2), WithBackoff(time.Millisecond, time.Millisecond*2))
+
+			rates, err := cli.Rates(context.Background(), option.WithCurrency("USD"))
+			assert.Equal(t, tt.wantCalls, doer.calls.Load())
+
+			if tt.wantErr {
+				require.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			_, err = rates.CurrencyByCode("USD")
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestClient_Rates_RetryCanceled(t *testing.T) {
+	doer := &sequenceDoer{responses: []func() (*http.Response, error){
+		respond(http.StatusServiceUnavailable, nil, ""),
+	}}
+	cli := NewWithHTTPClient(doer, WithBackoff(time.Minute, time.Minute))
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*20)
+	defer cancel()
+
+	_, err := cli.Rates(ctx, option.WithCurrency("USD"))
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, int32(1), doer.calls.Load())
+}
+
+func TestRetryPolicy_Delay(t *testing.T) {
+	p := retryPolicy{base: time.Millisecond * 100, max: time.Second}
+
+	tests := []struct {
+		name       string
+		attempt    int
+		retryAfter time.Duration
+		min, max   time.Duration
+	}{
+		{name: "first", attempt: 0, min: time.Millisecond * 50, max: time.Millisecond * 100},
+		{name: "second", attempt: 1, min: time.Millisecond * 100, max: time.Millisecond * 200},
+		{name: "capped", attempt: 10, min: time.Millisecond * 500, max: time.Second},
+		{name: "huge attempt", attempt: 100, min: time.Millisecond * 500, max: time.Second},
+		{name: "retry after", attempt: 0, retryAfter: time.Second * 3, min: time.Second * 3, max: time.Second * 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for range 100 {
+				d := p.delay(tt.attempt, tt.retryAfter)
+				assert.GreaterOrEqual(t, d, tt.min)
+				assert.LessOrEqual(t, d, tt.max)
+			}
+		})
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2023, 12, 1, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		raw  string
+		want time.Duration
+	}{
+		{raw: "", want: 0},
+		{raw: "120", want: time.Minute * 2},
+		{raw: "-5", want: 0},
+		{raw: now.Add(time.Second * 30).Format(http.TimeFormat), want: time.Second * 30},
+		{raw: now.Add(-time.Second * 30).Format(http.TimeFormat), want: 0},
+		{raw: "soon", want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.raw, func(t *testing.T) {
+			assert.Equal(t, tt.want, parseRetryAfter(tt.raw, now))
+		})
+	}
+}
+
+func TestLimiter_Wait(t *testing.T) {
+	l := newLimiter(100, 1)
+	ctx := context.Background()
+
+	start := time.Now()
+
+	for range 3 {
+		require.NoError(t, l.Wait(ctx))
+	}
+
+	// The first request uses burst, the next two wait for 10ms each.
+	assert.GreaterOrEqual(t, time.Since(start), time.Millisecond*15)
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+
+	slow := newLimiter(0.001, 1)
+
+	// Burst is available regardless of context, the next token is not.
+	require.NoError(t, slow.Wait(canceled))
+	require.ErrorIs(t, slow.Wait(canceled), context.Canceled)
+
+	var unlimited *limiter
+
+	require.NoError(t, unlimited.Wait(ctx))
+}
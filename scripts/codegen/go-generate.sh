@@ -9,8 +9,6 @@ SCRIPTS_DIR="${REPO_ROOT}/scripts"
 
 source "${SCRIPTS_DIR}/helpers-source.sh"
 
-checkInstalled 'stringer'
-
 go generate -x ./...
 
 echo "${SCRIPT_NAME} done."